@@ -0,0 +1,227 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"github.com/SpawNKZ/content_service/common/errors"
+	"github.com/SpawNKZ/content_service/common/validation"
+	contentRepo "github.com/SpawNKZ/content_service/content/repo"
+	contentService "github.com/SpawNKZ/content_service/content/service"
+	contentHistoryRepo "github.com/SpawNKZ/content_service/content_history/repo"
+	contentHistoryService "github.com/SpawNKZ/content_service/content_history/service"
+	"github.com/SpawNKZ/content_service/content_status/models"
+	contentStatusRepo "github.com/SpawNKZ/content_service/content_status/repo"
+	contentStatusService "github.com/SpawNKZ/content_service/content_status/service"
+	"github.com/SpawNKZ/content_service/db"
+	"github.com/SpawNKZ/content_service/utils"
+	"github.com/go-kit/log"
+	"go.mongodb.org/mongo-driver/mongo"
+	"io"
+	"os"
+	"os/signal"
+	"sort"
+	"strings"
+	"syscall"
+	"text/tabwriter"
+	"time"
+)
+
+// withDB runs fn with a Mongo connection, cancelled on SIGINT or SIGTERM.
+func withDB(config utils.Config, logger log.Logger, fn func(ctx context.Context, client *mongo.Client) error) error {
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer stop()
+
+	client, err := db.NewDBConnection(ctx, config.DBSource, logger)
+	if err != nil {
+		return err
+	}
+	defer client.Disconnect(context.Background())
+
+	return fn(ctx, client)
+}
+
+func newContentStatusService(client *mongo.Client, logger log.Logger) contentStatusService.Service {
+	var svc contentStatusService.Service
+	usage := contentService.NewStatusUsage(contentRepo.NewRepository(client), contentHistoryService.New(contentHistoryRepo.NewRepository(client)))
+	svc = contentStatusService.New(contentStatusRepo.NewRepository(client), usage)
+	svc = contentStatusService.NewLoggingService(log.With(logger, "component", "contentStatus"), svc)
+	return svc
+}
+
+func runSeed(config utils.Config, logger log.Logger, args []string) error {
+	fs := flag.NewFlagSet("seed", flag.ExitOnError)
+	fs.Parse(args)
+
+	return withDB(config, logger, func(ctx context.Context, client *mongo.Client) error {
+		if err := db.EnsureIndexes(ctx, client); err != nil {
+			return err
+		}
+		return contentStatusService.Seed(ctx, contentStatusRepo.NewRepository(client))
+	})
+}
+
+func runMigrate(config utils.Config, logger log.Logger, args []string) error {
+	fs := flag.NewFlagSet("migrate", flag.ExitOnError)
+	fs.Parse(args)
+
+	return withDB(config, logger, func(ctx context.Context, client *mongo.Client) error {
+		return db.Migrate(ctx, client, logger)
+	})
+}
+
+func runReindex(config utils.Config, logger log.Logger, args []string) error {
+	fs := flag.NewFlagSet("reindex", flag.ExitOnError)
+	fs.Parse(args)
+
+	return withDB(config, logger, func(ctx context.Context, client *mongo.Client) error {
+		return db.Reindex(ctx, client)
+	})
+}
+
+func runExport(config utils.Config, logger log.Logger, args []string) error {
+	fs := flag.NewFlagSet("export", flag.ExitOnError)
+	var (
+		out         = fs.String("out", "-", "file to write, - for the standard output")
+		collections = fs.String("collections", strings.Join(db.Collections, ","), "comma-separated collections to export")
+	)
+	fs.Parse(args)
+
+	var w io.Writer = os.Stdout
+	if *out != "-" {
+		f, err := os.Create(*out)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		w = f
+	}
+
+	return withDB(config, logger, func(ctx context.Context, client *mongo.Client) error {
+		count, err := db.Export(ctx, client, w, strings.Split(*collections, ","))
+		logger.Log("exported", count)
+		return err
+	})
+}
+
+func runImport(config utils.Config, logger log.Logger, args []string) error {
+	fs := flag.NewFlagSet("import", flag.ExitOnError)
+	in := fs.String("in", "-", "file to read, - for the standard input")
+	fs.Parse(args)
+
+	var r io.Reader = os.Stdin
+	if *in != "-" {
+		f, err := os.Open(*in)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		r = f
+	}
+
+	return withDB(config, logger, func(ctx context.Context, client *mongo.Client) error {
+		count, err := db.Import(ctx, client, r)
+		logger.Log("imported", count)
+		return err
+	})
+}
+
+func runPurgeDeleted(config utils.Config, logger log.Logger, args []string) error {
+	fs := flag.NewFlagSet("purge-deleted", flag.ExitOnError)
+	olderThan := fs.Duration("older-than", 30*24*time.Hour, "purge the documents deleted at least this long ago")
+	fs.Parse(args)
+
+	return withDB(config, logger, func(ctx context.Context, client *mongo.Client) error {
+		purged, err := db.PurgeDeleted(ctx, client, time.Now().Add(-*olderThan))
+		collections := make([]string, 0, len(purged))
+		for collection := range purged {
+			collections = append(collections, collection)
+		}
+		sort.Strings(collections)
+		for _, collection := range collections {
+			fmt.Printf("purged %d documents from %s\n", purged[collection], collection)
+		}
+		return err
+	})
+}
+
+func runStatus(config utils.Config, logger log.Logger, args []string) error {
+	if len(args) == 0 {
+		return fmt.Errorf("status: expected list or create")
+	}
+
+	switch args[0] {
+	case "list":
+		fs := flag.NewFlagSet("status list", flag.ExitOnError)
+		var (
+			limit  = fs.Int("limit", models.MaxLimit, "number of statuses to list")
+			offset = fs.Int("offset", 0, "number of statuses to skip")
+		)
+		fs.Parse(args[1:])
+
+		return withDB(config, logger, func(ctx context.Context, client *mongo.Client) error {
+			statuses, pagination, err := newContentStatusService(client, logger).GetList(ctx, models.GetListRequest{
+				ReqPagination: models.ReqPagination{Limit: *limit, Offset: *offset},
+			})
+			if err != nil {
+				return err
+			}
+
+			w := tabwriter.NewWriter(os.Stdout, 0, 4, 2, ' ', 0)
+			fmt.Fprintln(w, "ID\tNAME\tORDER\tCOLOR\tREMOVABLE")
+			for _, status := range statuses {
+				fmt.Fprintf(w, "%s\t%s\t%d\t%s\t%t\n", status.ID, status.Name, status.Order, status.Color, status.IsRemovable)
+			}
+			if err := w.Flush(); err != nil {
+				return err
+			}
+			fmt.Printf("%d of %d\n", len(statuses), pagination.Total)
+			return nil
+		})
+	case "create":
+		fs := flag.NewFlagSet("status create", flag.ExitOnError)
+		var (
+			name        = fs.String("name", "", "name of the status")
+			removable   = fs.Bool("removable", true, "whether the status can be deleted")
+			order       = fs.Int("order", 0, "display order of the status")
+			color       = fs.String("color", "", "display color of the status, such as #1e90ff")
+			description = fs.String("description", "", "description of the status")
+		)
+		fs.Parse(args[1:])
+
+		req := models.CreateRequest{
+			Name:        *name,
+			IsRemovable: *removable,
+			Order:       *order,
+			Color:       *color,
+			Description: *description,
+		}
+		if err := validation.Validate(req); err != nil {
+			return describe(err)
+		}
+
+		return withDB(config, logger, func(ctx context.Context, client *mongo.Client) error {
+			id, err := newContentStatusService(client, logger).Create(ctx, req)
+			if err != nil {
+				return err
+			}
+			fmt.Println(id)
+			return nil
+		})
+	}
+	return fmt.Errorf("status: unknown command %q, expected list or create", args[0])
+}
+
+// describe spells out the field errors that the message of an application
+// error leaves out.
+func describe(err error) error {
+	appErr := errors.FromError(err)
+	if len(appErr.Fields) == 0 {
+		return err
+	}
+	fields := make([]string, 0, len(appErr.Fields))
+	for _, field := range appErr.Fields {
+		fields = append(fields, field.Field+": "+field.Message)
+	}
+	return fmt.Errorf("%s: %s", appErr.Message, strings.Join(fields, "; "))
+}
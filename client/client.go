@@ -0,0 +1,242 @@
+// Package client calls the content service over HTTP through the same
+// Service interfaces the service implements, so that callers can swap the
+// local implementation for a remote one.
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	stdErrors "errors"
+	"fmt"
+	"github.com/SpawNKZ/content_service/common/errors"
+	"github.com/SpawNKZ/content_service/common/requestid"
+	"github.com/SpawNKZ/content_service/common/transport"
+	"github.com/go-kit/kit/auth/jwt"
+	"github.com/go-kit/kit/endpoint"
+	httpTransport "github.com/go-kit/kit/transport/http"
+	"io"
+	"net/http"
+	"net/url"
+	"path"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Config describes how the clients reach the service.
+type Config struct {
+	// Token is sent as the bearer token of every request, unless the context
+	// carries one put by jwt.HTTPToContext, e.g. the token of the request
+	// being served by the caller.
+	Token string
+	// Timeout bounds a single attempt, zero means no timeout.
+	Timeout time.Duration
+	// Retries is the number of attempts made after the first one fails.
+	// Only the reads, updates and deletes are retried, and only when the
+	// failure is not the client's fault.
+	Retries int
+	// Backoff is the wait before the first retry, doubled on each next one.
+	Backoff time.Duration
+	// HTTPClient sends the requests, http.DefaultClient when nil.
+	HTTPClient *http.Client
+}
+
+// Error is an error returned by the service. It unwraps to the application
+// error with the code sent by the service, so that errors.Is matches it
+// against the errors of the common/errors package.
+type Error struct {
+	Err       *errors.AppError
+	RequestId string
+}
+
+func (e *Error) Error() string {
+	if e.RequestId == "" {
+		return e.Err.Error()
+	}
+	return fmt.Sprintf("%s (request %s)", e.Err.Error(), e.RequestId)
+}
+
+func (e *Error) Unwrap() error {
+	return e.Err
+}
+
+// envelope is the client side of transport.OperationResult.
+type envelope struct {
+	Success bool            `json:"success"`
+	Message string          `json:"message"`
+	Data    json.RawMessage `json:"data"`
+	Failure *errors.Body    `json:"error"`
+}
+
+type client struct {
+	base   *url.URL
+	config Config
+}
+
+func newClient(instance string, config Config) (*client, error) {
+	if !strings.HasPrefix(instance, "http") {
+		instance = "http://" + instance
+	}
+	base, err := url.Parse(instance)
+	if err != nil {
+		return nil, err
+	}
+	base.Path = strings.TrimSuffix(base.Path, "/")
+	return &client{base: base, config: config}, nil
+}
+
+// endpoint builds the endpoint of a route of the resource, the response data
+// is decoded into the value returned by newData.
+func (c *client) endpoint(method string, resource string, enc httpTransport.EncodeRequestFunc, newData func() interface{}) endpoint.Endpoint {
+	target := *c.base
+	target.Path = path.Join(target.Path, "/api/v1", resource)
+
+	options := []httpTransport.ClientOption{
+		httpTransport.ClientBefore(c.setHeaders, jwt.ContextToHTTP()),
+	}
+	if c.config.HTTPClient != nil {
+		options = append(options, httpTransport.SetClient(c.config.HTTPClient))
+	}
+
+	e := httpTransport.NewClient(method, &target, enc, decodeResponse(newData), options...).Endpoint()
+	if c.config.Timeout > 0 {
+		e = timeout(c.config.Timeout, e)
+	}
+	if method != http.MethodPost {
+		e = retry(c.config.Retries, c.config.Backoff, e)
+	}
+	return e
+}
+
+func (c *client) setHeaders(ctx context.Context, r *http.Request) context.Context {
+	r.Header.Set("Accept", transport.ContentTypeJSON)
+	if id := requestid.FromContext(ctx); id != "" {
+		r.Header.Set(requestid.Header, id)
+	}
+	if c.config.Token != "" {
+		r.Header.Set("Authorization", "Bearer "+c.config.Token)
+	}
+	return ctx
+}
+
+func decodeResponse(newData func() interface{}) httpTransport.DecodeResponseFunc {
+	return func(_ context.Context, r *http.Response) (interface{}, error) {
+		body, err := io.ReadAll(r.Body)
+		if err != nil {
+			return nil, err
+		}
+
+		var result envelope
+		if err := json.Unmarshal(body, &result); err != nil {
+			if r.StatusCode >= http.StatusBadRequest {
+				return nil, &Error{Err: errors.New("http_"+strconv.Itoa(r.StatusCode), r.StatusCode, http.StatusText(r.StatusCode))}
+			}
+			return nil, err
+		}
+		if !result.Success || r.StatusCode >= http.StatusBadRequest {
+			return nil, toError(r.StatusCode, result)
+		}
+
+		data := newData()
+		if len(result.Data) > 0 && string(result.Data) != "null" {
+			if err := json.Unmarshal(result.Data, data); err != nil {
+				return nil, err
+			}
+		}
+		return data, nil
+	}
+}
+
+func toError(status int, result envelope) *Error {
+	if result.Failure == nil {
+		return &Error{Err: errors.New("http_"+strconv.Itoa(status), status, result.Message)}
+	}
+	appErr := errors.New(result.Failure.Code, status, result.Failure.Message).
+		WithDetails(result.Failure.Details).
+		WithFields(result.Failure.Fields...)
+	return &Error{Err: appErr, RequestId: result.Failure.RequestId}
+}
+
+// encodeJSON encodes the request as the JSON body.
+func encodeJSON(_ context.Context, r *http.Request, request interface{}) error {
+	return setJSONBody(r, request)
+}
+
+// encodeNothing sends a request without body, for routes whose parameters
+// are all in the path.
+func encodeNothing(context.Context, *http.Request, interface{}) error {
+	return nil
+}
+
+func setJSONBody(r *http.Request, v interface{}) error {
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(v); err != nil {
+		return err
+	}
+	r.Header.Set("Content-Type", transport.ContentTypeJSON)
+	r.ContentLength = int64(buf.Len())
+	r.Body = io.NopCloser(&buf)
+	return nil
+}
+
+// appendPath appends the escaped segments to the path of the request.
+func appendPath(r *http.Request, segments ...string) {
+	for _, segment := range segments {
+		r.URL.Path += "/" + url.PathEscape(segment)
+	}
+}
+
+// setQuery sets the pagination and the non-empty filters as query parameters.
+func setQuery(r *http.Request, limit int, offset int, filters map[string]string) {
+	q := r.URL.Query()
+	if limit > 0 {
+		q.Set("limit", strconv.Itoa(limit))
+	}
+	if offset > 0 {
+		q.Set("offset", strconv.Itoa(offset))
+	}
+	for name, value := range filters {
+		if value != "" {
+			q.Set(name, value)
+		}
+	}
+	r.URL.RawQuery = q.Encode()
+}
+
+func timeout(d time.Duration, next endpoint.Endpoint) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		ctx, cancel := context.WithTimeout(ctx, d)
+		defer cancel()
+		return next(ctx, request)
+	}
+}
+
+func retry(retries int, backoff time.Duration, next endpoint.Endpoint) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (response interface{}, err error) {
+		wait := backoff
+		for attempt := 0; ; attempt++ {
+			response, err = next(ctx, request)
+			if err == nil || attempt >= retries || !retryable(err) {
+				return response, err
+			}
+
+			select {
+			case <-ctx.Done():
+				return nil, ctx.Err()
+			case <-time.After(wait):
+			}
+			wait *= 2
+		}
+	}
+}
+
+// retryable reports whether the call may succeed if made again: the service
+// could not be reached or failed on its side.
+func retryable(err error) bool {
+	var e *Error
+	if stdErrors.As(err, &e) {
+		return e.Err.Status >= http.StatusInternalServerError
+	}
+	return true
+}
@@ -0,0 +1,145 @@
+package client
+
+import (
+	"context"
+	stdErrors "errors"
+	"github.com/SpawNKZ/content_service/common/auth"
+	"github.com/SpawNKZ/content_service/common/errors"
+	"github.com/SpawNKZ/content_service/content/models"
+	contentService "github.com/SpawNKZ/content_service/content/service"
+	content "github.com/SpawNKZ/content_service/content/transports"
+	"github.com/go-kit/log"
+	"github.com/golang-jwt/jwt/v4"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+)
+
+const (
+	secret    = "secret"
+	contentId = "64b000000000000000000001"
+)
+
+func TestErrorsMapBackToAppErrors(t *testing.T) {
+	svc := &recordingContentService{err: errors.ErrNotFound, failures: 1}
+	c, _ := newContentServer(t, svc, Config{})
+
+	_, err := c.GetOne(context.Background(), models.IdRequest{ID: contentId})
+	var clientErr *Error
+	if !stdErrors.As(err, &clientErr) || !errors.Is(err, errors.ErrNotFound) || clientErr.Err.Status != http.StatusNotFound {
+		t.Fatalf("err = %#v, want %s served with 404", err, errors.ErrNotFound.Code)
+	}
+
+	// Rejected by the validation middleware, before the service.
+	err = c.AssignAuthor(context.Background(), models.AssignAuthorRequest{ID: contentId})
+	if !stdErrors.As(err, &clientErr) || !errors.Is(err, errors.ErrValidation) {
+		t.Fatalf("err = %v, want %s", err, errors.ErrValidation.Code)
+	}
+	if fields := clientErr.Err.Fields; len(fields) != 1 || fields[0].Field != "author_id" || fields[0].Message != "is required" {
+		t.Fatalf("fields = %+v, want author_id is required", fields)
+	}
+}
+
+func TestRetry(t *testing.T) {
+	tests := []struct {
+		name      string
+		call      func(c contentService.Service) error
+		err       *errors.AppError
+		failures  int
+		wantCalls int
+		wantErr   *errors.AppError
+	}{
+		{
+			name: "server failures are retried",
+			call: func(c contentService.Service) error {
+				_, err := c.GetOne(context.Background(), models.IdRequest{ID: contentId})
+				return err
+			},
+			err: errors.ErrDB, failures: 2, wantCalls: 3,
+		},
+		{
+			name: "retries run out",
+			call: func(c contentService.Service) error {
+				return c.Delete(context.Background(), models.IdRequest{ID: contentId})
+			},
+			err: errors.ErrDB, failures: 5, wantCalls: 3, wantErr: errors.ErrDB,
+		},
+		{
+			name: "client errors are not retried",
+			call: func(c contentService.Service) error {
+				_, err := c.GetOne(context.Background(), models.IdRequest{ID: contentId})
+				return err
+			},
+			err: errors.ErrNotFound, failures: 1, wantCalls: 1, wantErr: errors.ErrNotFound,
+		},
+		{
+			name: "creates are not retried",
+			call: func(c contentService.Service) error {
+				_, err := c.Create(context.Background(), models.CreateRequest{Locale: "ru", Body: "body", SubjectId: 1, MicrotopicId: 1})
+				return err
+			},
+			err: errors.ErrDB, failures: 1, wantCalls: 1, wantErr: errors.ErrDB,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := &recordingContentService{err: tt.err, failures: tt.failures}
+			c, _ := newContentServer(t, svc, Config{Retries: 2})
+
+			err := tt.call(c)
+			if tt.wantErr == nil && err != nil || tt.wantErr != nil && !errors.Is(err, tt.wantErr) {
+				t.Fatalf("err = %v, want %v", err, tt.wantErr)
+			}
+			if len(svc.calls) != tt.wantCalls {
+				t.Fatalf("service called %d times, want %d", len(svc.calls), tt.wantCalls)
+			}
+		})
+	}
+}
+
+// newContentServer serves svc with the content handler and returns a client
+// of it, signed in as an admin, and the recorder of the requests served.
+func newContentServer(t *testing.T, svc contentService.Service, config Config) (contentService.Service, *pathRecorder) {
+	paths := &pathRecorder{handler: content.MakeHTTPHandler(svc, auth.NewParser(secret), log.NewNopLogger())}
+	c, err := NewContentClient(serve(t, paths), signedIn(t, config))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return c, paths
+}
+
+// serve serves handler until the end of the test and returns its address.
+func serve(t *testing.T, handler http.Handler) string {
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+	return server.URL
+}
+
+// signedIn signs config in as an admin.
+func signedIn(t *testing.T, config Config) Config {
+	config.Token = token(t, "root", auth.GroupAdmin)
+	return config
+}
+
+func token(t *testing.T, userId string, groups ...string) string {
+	signed, err := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{"sub": userId, "groups": groups}).SignedString([]byte(secret))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return signed
+}
+
+// pathRecorder records the path and query of every request it serves.
+type pathRecorder struct {
+	handler http.Handler
+	mu      sync.Mutex
+	paths   []string
+}
+
+func (p *pathRecorder) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	p.mu.Lock()
+	p.paths = append(p.paths, r.Method+" "+r.URL.RequestURI())
+	p.mu.Unlock()
+	p.handler.ServeHTTP(w, r)
+}
@@ -0,0 +1,163 @@
+package client
+
+import (
+	"context"
+	"github.com/SpawNKZ/content_service/content/models"
+	contentService "github.com/SpawNKZ/content_service/content/service"
+	"github.com/go-kit/kit/endpoint"
+	"net/http"
+	"strconv"
+)
+
+const contentResource = "content"
+
+type contentClient struct {
+	create            endpoint.Endpoint
+	getOne            endpoint.Endpoint
+	update            endpoint.Endpoint
+	assignAuthor      endpoint.Endpoint
+	addContributor    endpoint.Endpoint
+	removeContributor endpoint.Endpoint
+	changeStatus      endpoint.Endpoint
+	delete            endpoint.Endpoint
+	getList           endpoint.Endpoint
+}
+
+// NewContentClient returns the content service served at instance, e.g.
+// "http://content-service:8080".
+func NewContentClient(instance string, config Config) (contentService.Service, error) {
+	c, err := newClient(instance, config)
+	if err != nil {
+		return nil, err
+	}
+
+	return &contentClient{
+		create: c.endpoint(http.MethodPost, contentResource, encodeJSON,
+			func() interface{} { return &models.CreateResponse{} }),
+		getOne: c.endpoint(http.MethodGet, contentResource, encodeContentIdRequest,
+			func() interface{} { return &models.GetOneResponse{} }),
+		update: c.endpoint(http.MethodPut, contentResource, encodeContentUpdateRequest,
+			func() interface{} { return &models.UpdateResponse{} }),
+		assignAuthor: c.endpoint(http.MethodPut, contentResource, encodeAssignAuthorRequest,
+			func() interface{} { return &models.UpdateResponse{} }),
+		addContributor: c.endpoint(http.MethodPost, contentResource, encodeAddContributorRequest,
+			func() interface{} { return &models.UpdateResponse{} }),
+		removeContributor: c.endpoint(http.MethodDelete, contentResource, encodeRemoveContributorRequest,
+			func() interface{} { return &models.UpdateResponse{} }),
+		changeStatus: c.endpoint(http.MethodPut, contentResource, encodeChangeStatusRequest,
+			func() interface{} { return &models.UpdateResponse{} }),
+		delete: c.endpoint(http.MethodDelete, contentResource, encodeContentIdRequest,
+			func() interface{} { return &models.DeleteOneResponse{} }),
+		getList: c.endpoint(http.MethodGet, contentResource, encodeContentGetListRequest,
+			func() interface{} { return &models.GetListResponse{} }),
+	}, nil
+}
+
+func (c *contentClient) Create(ctx context.Context, data models.CreateRequest) (string, error) {
+	res, err := c.create(ctx, data)
+	if err != nil {
+		return "", err
+	}
+	return res.(*models.CreateResponse).ID, nil
+}
+
+func (c *contentClient) GetOne(ctx context.Context, data models.IdRequest) (*models.Content, error) {
+	res, err := c.getOne(ctx, data)
+	if err != nil {
+		return nil, err
+	}
+	return res.(*models.GetOneResponse).Content, nil
+}
+
+func (c *contentClient) Update(ctx context.Context, data models.UpdateRequest) error {
+	_, err := c.update(ctx, data)
+	return err
+}
+
+func (c *contentClient) AssignAuthor(ctx context.Context, data models.AssignAuthorRequest) error {
+	_, err := c.assignAuthor(ctx, data)
+	return err
+}
+
+func (c *contentClient) AddContributor(ctx context.Context, data models.ContributorRequest) error {
+	_, err := c.addContributor(ctx, data)
+	return err
+}
+
+func (c *contentClient) RemoveContributor(ctx context.Context, data models.ContributorRequest) error {
+	_, err := c.removeContributor(ctx, data)
+	return err
+}
+
+func (c *contentClient) ChangeStatus(ctx context.Context, data models.ChangeStatusRequest) error {
+	_, err := c.changeStatus(ctx, data)
+	return err
+}
+
+func (c *contentClient) Delete(ctx context.Context, data models.IdRequest) error {
+	_, err := c.delete(ctx, data)
+	return err
+}
+
+func (c *contentClient) GetList(ctx context.Context, data models.GetListRequest) ([]*models.Content, models.Pagination, error) {
+	res, err := c.getList(ctx, data)
+	if err != nil {
+		return nil, models.Pagination{}, err
+	}
+	list := res.(*models.GetListResponse)
+	return list.ContentList, list.Pagination, nil
+}
+
+func encodeContentIdRequest(_ context.Context, r *http.Request, request interface{}) error {
+	appendPath(r, request.(models.IdRequest).ID)
+	return nil
+}
+
+func encodeContentUpdateRequest(_ context.Context, r *http.Request, request interface{}) error {
+	req := request.(models.UpdateRequest)
+	appendPath(r, req.ID)
+	return setJSONBody(r, req)
+}
+
+func encodeAssignAuthorRequest(_ context.Context, r *http.Request, request interface{}) error {
+	req := request.(models.AssignAuthorRequest)
+	appendPath(r, "assign", req.ID)
+	return setJSONBody(r, req)
+}
+
+func encodeAddContributorRequest(_ context.Context, r *http.Request, request interface{}) error {
+	req := request.(models.ContributorRequest)
+	appendPath(r, req.ID, "contributors")
+	return setJSONBody(r, req)
+}
+
+func encodeRemoveContributorRequest(_ context.Context, r *http.Request, request interface{}) error {
+	req := request.(models.ContributorRequest)
+	appendPath(r, req.ID, "contributors", req.UserId)
+	setQuery(r, 0, 0, map[string]string{"role": req.Role})
+	return nil
+}
+
+func encodeChangeStatusRequest(_ context.Context, r *http.Request, request interface{}) error {
+	req := request.(models.ChangeStatusRequest)
+	appendPath(r, "change-status", req.ID)
+	return setJSONBody(r, req)
+}
+
+func encodeContentGetListRequest(_ context.Context, r *http.Request, request interface{}) error {
+	req := request.(models.GetListRequest)
+	filters := map[string]string{
+		"locale":         req.Locale,
+		"status":         req.Status,
+		"author_id":      req.AuthorId,
+		"contributor_id": req.ContributorId,
+	}
+	if req.SubjectId != 0 {
+		filters["subject_id"] = strconv.FormatInt(req.SubjectId, 10)
+	}
+	if req.MicrotopicId != 0 {
+		filters["microtopic_id"] = strconv.FormatInt(req.MicrotopicId, 10)
+	}
+	setQuery(r, req.Limit, req.Offset, filters)
+	return nil
+}
@@ -0,0 +1,118 @@
+package client
+
+import (
+	"context"
+	"github.com/SpawNKZ/content_service/common/errors"
+	"github.com/SpawNKZ/content_service/content_status/models"
+	contentStatusService "github.com/SpawNKZ/content_service/content_status/service"
+	"github.com/go-kit/kit/endpoint"
+	"net/http"
+)
+
+const contentStatusResource = "content_status"
+
+type contentStatusClient struct {
+	create  endpoint.Endpoint
+	getOne  endpoint.Endpoint
+	update  endpoint.Endpoint
+	delete  endpoint.Endpoint
+	getList endpoint.Endpoint
+}
+
+// NewContentStatusClient returns the content status service served at instance.
+func NewContentStatusClient(instance string, config Config) (contentStatusService.Service, error) {
+	c, err := newClient(instance, config)
+	if err != nil {
+		return nil, err
+	}
+
+	return &contentStatusClient{
+		create: c.endpoint(http.MethodPost, contentStatusResource, encodeJSON,
+			func() interface{} { return &models.CreateResponse{} }),
+		getOne: c.endpoint(http.MethodGet, contentStatusResource, encodeContentStatusIdRequest,
+			func() interface{} { return &models.GetOneResponse{} }),
+		update: c.endpoint(http.MethodPut, contentStatusResource, encodeContentStatusUpdateRequest,
+			func() interface{} { return &models.UpdateResponse{} }),
+		delete: c.endpoint(http.MethodDelete, contentStatusResource, encodeContentStatusDeleteRequest,
+			func() interface{} { return &models.DeleteOneResponse{} }),
+		getList: c.endpoint(http.MethodGet, contentStatusResource, encodeContentStatusGetListRequest,
+			func() interface{} { return &models.GetListResponse{} }),
+	}, nil
+}
+
+func (c *contentStatusClient) Create(ctx context.Context, data models.CreateRequest) (string, error) {
+	res, err := c.create(ctx, data)
+	if err != nil {
+		return "", err
+	}
+	return res.(*models.CreateResponse).ID, nil
+}
+
+func (c *contentStatusClient) GetOne(ctx context.Context, data models.IdRequest) (*models.ContentStatus, error) {
+	res, err := c.getOne(ctx, data)
+	if err != nil {
+		return nil, err
+	}
+	return res.(*models.GetOneResponse).ContentStatus, nil
+}
+
+// GetByName has no route of its own, the list is filtered on the name, which
+// is unique.
+func (c *contentStatusClient) GetByName(ctx context.Context, name string) (*models.ContentStatus, error) {
+	statuses, _, err := c.GetList(ctx, models.GetListRequest{
+		ReqPagination:       models.ReqPagination{Limit: 1},
+		ContentStatusFilter: models.ContentStatusFilter{Name: name},
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(statuses) == 0 {
+		return nil, errors.ErrNotFound
+	}
+	return statuses[0], nil
+}
+
+func (c *contentStatusClient) Update(ctx context.Context, data models.UpdateRequest) error {
+	_, err := c.update(ctx, data)
+	return err
+}
+
+func (c *contentStatusClient) Delete(ctx context.Context, data models.DeleteRequest) error {
+	_, err := c.delete(ctx, data)
+	return err
+}
+
+func (c *contentStatusClient) GetList(ctx context.Context, data models.GetListRequest) ([]*models.ContentStatus, models.Pagination, error) {
+	res, err := c.getList(ctx, data)
+	if err != nil {
+		return nil, models.Pagination{}, err
+	}
+	list := res.(*models.GetListResponse)
+	return list.ContentStatusList, list.Pagination, nil
+}
+
+func encodeContentStatusIdRequest(_ context.Context, r *http.Request, request interface{}) error {
+	req := request.(models.IdRequest)
+	appendPath(r, req.ID)
+	setQuery(r, 0, 0, map[string]string{"locale": req.Locale})
+	return nil
+}
+
+func encodeContentStatusDeleteRequest(_ context.Context, r *http.Request, request interface{}) error {
+	req := request.(models.DeleteRequest)
+	appendPath(r, req.ID)
+	setQuery(r, 0, 0, map[string]string{"migrate_to": req.MigrateTo})
+	return nil
+}
+
+func encodeContentStatusUpdateRequest(_ context.Context, r *http.Request, request interface{}) error {
+	req := request.(models.UpdateRequest)
+	appendPath(r, req.ID)
+	return setJSONBody(r, req)
+}
+
+func encodeContentStatusGetListRequest(_ context.Context, r *http.Request, request interface{}) error {
+	req := request.(models.GetListRequest)
+	setQuery(r, req.Limit, req.Offset, map[string]string{"locale": req.Locale, "name": req.Name})
+	return nil
+}
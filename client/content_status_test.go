@@ -0,0 +1,57 @@
+package client
+
+import (
+	"context"
+	"github.com/SpawNKZ/content_service/common/auth"
+	"github.com/SpawNKZ/content_service/common/errors"
+	"github.com/SpawNKZ/content_service/content_status/models"
+	contentStatusService "github.com/SpawNKZ/content_service/content_status/service"
+	contentStatus "github.com/SpawNKZ/content_service/content_status/transports"
+	"github.com/go-kit/log"
+	"reflect"
+	"testing"
+)
+
+func TestContentStatusGetByName(t *testing.T) {
+	svc := &listingStatusService{statuses: []*models.ContentStatus{
+		{ID: "64b000000000000000000011", Name: models.StatusDraft},
+		{ID: "64b000000000000000000012", Name: models.StatusPublished},
+	}}
+	paths := &pathRecorder{handler: contentStatus.MakeHTTPHandler(svc, auth.NewParser(secret), log.NewNopLogger())}
+	c, err := NewContentStatusClient(serve(t, paths), Config{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	status, err := c.GetByName(context.Background(), models.StatusPublished)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if status.ID != "64b000000000000000000012" {
+		t.Fatalf("got %+v, want the published status", status)
+	}
+	if want := []string{"GET /api/v1/content_status?limit=1&name=published"}; !reflect.DeepEqual(paths.paths, want) {
+		t.Fatalf("requested %v, want %v", paths.paths, want)
+	}
+
+	_, err = c.GetByName(context.Background(), "missing")
+	if !errors.Is(err, errors.ErrNotFound) {
+		t.Fatalf("err = %v, want %s", err, errors.ErrNotFound.Code)
+	}
+}
+
+// listingStatusService lists its statuses filtered as the repository does.
+type listingStatusService struct {
+	contentStatusService.Service
+	statuses []*models.ContentStatus
+}
+
+func (s *listingStatusService) GetList(_ context.Context, data models.GetListRequest) ([]*models.ContentStatus, models.Pagination, error) {
+	var statuses []*models.ContentStatus
+	for _, status := range s.statuses {
+		if data.Name == "" || status.Name == data.Name {
+			statuses = append(statuses, status)
+		}
+	}
+	return statuses, models.Pagination{Total: len(statuses), Limit: data.Limit, IsLastPage: true}, nil
+}
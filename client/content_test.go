@@ -0,0 +1,141 @@
+package client
+
+import (
+	"context"
+	"github.com/SpawNKZ/content_service/content/models"
+	contentService "github.com/SpawNKZ/content_service/content/service"
+	"reflect"
+	"sync"
+	"testing"
+)
+
+func TestContentRoutes(t *testing.T) {
+	const statusId = "64b000000000000000000002"
+	ctx := context.Background()
+	tests := []struct {
+		name     string
+		call     func(c contentService.Service) error
+		wantPath string
+		want     interface{}
+	}{
+		{
+			name: "update",
+			call: func(c contentService.Service) error {
+				return c.Update(ctx, models.UpdateRequest{ID: contentId})
+			},
+			wantPath: "PUT /api/v1/content/" + contentId,
+			want:     models.UpdateRequest{ID: contentId},
+		},
+		{
+			name: "assign author",
+			call: func(c contentService.Service) error {
+				return c.AssignAuthor(ctx, models.AssignAuthorRequest{ID: contentId, AuthorId: "bob"})
+			},
+			wantPath: "PUT /api/v1/content/assign/" + contentId,
+			want:     models.AssignAuthorRequest{ID: contentId, AuthorId: "bob"},
+		},
+		{
+			name: "change status",
+			call: func(c contentService.Service) error {
+				return c.ChangeStatus(ctx, models.ChangeStatusRequest{ID: contentId, StatusId: statusId})
+			},
+			wantPath: "PUT /api/v1/content/change-status/" + contentId,
+			want:     models.ChangeStatusRequest{ID: contentId, StatusId: statusId},
+		},
+		{
+			name: "add contributor",
+			call: func(c contentService.Service) error {
+				return c.AddContributor(ctx, models.ContributorRequest{ID: contentId, UserId: "tom", Role: models.RoleTranslator})
+			},
+			wantPath: "POST /api/v1/content/" + contentId + "/contributors",
+			want:     models.ContributorRequest{ID: contentId, UserId: "tom", Role: models.RoleTranslator},
+		},
+		{
+			name: "remove contributor",
+			call: func(c contentService.Service) error {
+				return c.RemoveContributor(ctx, models.ContributorRequest{ID: contentId, UserId: "tom", Role: models.RoleTranslator})
+			},
+			wantPath: "DELETE /api/v1/content/" + contentId + "/contributors/tom?role=translator",
+			want:     models.ContributorRequest{ID: contentId, UserId: "tom", Role: models.RoleTranslator},
+		},
+		{
+			name: "delete",
+			call: func(c contentService.Service) error {
+				return c.Delete(ctx, models.IdRequest{ID: contentId})
+			},
+			wantPath: "DELETE /api/v1/content/" + contentId,
+			want:     models.IdRequest{ID: contentId},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := &recordingContentService{}
+			c, paths := newContentServer(t, svc, Config{})
+
+			if err := tt.call(c); err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(paths.paths, []string{tt.wantPath}) {
+				t.Fatalf("requested %v, want %s", paths.paths, tt.wantPath)
+			}
+			if len(svc.calls) != 1 || !reflect.DeepEqual(svc.calls[0], tt.want) {
+				t.Fatalf("service got %+v, want %+v", svc.calls, tt.want)
+			}
+		})
+	}
+}
+
+// recordingContentService records the requests it gets. The first failures
+// calls fail with err.
+type recordingContentService struct {
+	contentService.Service
+	err      error
+	failures int
+	mu       sync.Mutex
+	calls    []interface{}
+}
+
+func (s *recordingContentService) record(request interface{}) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.calls = append(s.calls, request)
+	if s.err != nil && len(s.calls) <= s.failures {
+		return s.err
+	}
+	return nil
+}
+
+func (s *recordingContentService) Create(_ context.Context, data models.CreateRequest) (string, error) {
+	return contentId, s.record(data)
+}
+
+func (s *recordingContentService) GetOne(_ context.Context, data models.IdRequest) (*models.Content, error) {
+	if err := s.record(data); err != nil {
+		return nil, err
+	}
+	return &models.Content{ID: data.ID}, nil
+}
+
+func (s *recordingContentService) Update(_ context.Context, data models.UpdateRequest) error {
+	return s.record(data)
+}
+
+func (s *recordingContentService) AssignAuthor(_ context.Context, data models.AssignAuthorRequest) error {
+	return s.record(data)
+}
+
+func (s *recordingContentService) AddContributor(_ context.Context, data models.ContributorRequest) error {
+	return s.record(data)
+}
+
+func (s *recordingContentService) RemoveContributor(_ context.Context, data models.ContributorRequest) error {
+	return s.record(data)
+}
+
+func (s *recordingContentService) ChangeStatus(_ context.Context, data models.ChangeStatusRequest) error {
+	return s.record(data)
+}
+
+func (s *recordingContentService) Delete(_ context.Context, data models.IdRequest) error {
+	return s.record(data)
+}
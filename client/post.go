@@ -0,0 +1,121 @@
+package client
+
+import (
+	"context"
+	"github.com/SpawNKZ/content_service/post/models"
+	postService "github.com/SpawNKZ/content_service/post/service"
+	"github.com/go-kit/kit/endpoint"
+	"net/http"
+)
+
+const postResource = "post"
+
+type postClient struct {
+	create  endpoint.Endpoint
+	getOne  endpoint.Endpoint
+	update  endpoint.Endpoint
+	delete  endpoint.Endpoint
+	reorder endpoint.Endpoint
+	getList endpoint.Endpoint
+}
+
+// NewPostClient returns the post service served at instance.
+func NewPostClient(instance string, config Config) (postService.Service, error) {
+	c, err := newClient(instance, config)
+	if err != nil {
+		return nil, err
+	}
+
+	return &postClient{
+		create: c.endpoint(http.MethodPost, postResource, encodeJSON,
+			func() interface{} { return &models.CreateResponse{} }),
+		getOne: c.endpoint(http.MethodGet, postResource, encodePostIdRequest,
+			func() interface{} { return &models.GetOneResponse{} }),
+		update: c.endpoint(http.MethodPut, postResource, encodePostUpdateRequest,
+			func() interface{} { return &models.UpdateResponse{} }),
+		delete: c.endpoint(http.MethodDelete, postResource, encodePostIdRequest,
+			func() interface{} { return &models.DeleteOneResponse{} }),
+		reorder: c.endpoint(http.MethodPut, postResource, encodeReorderRequest,
+			func() interface{} { return &models.UpdateResponse{} }),
+		getList: c.endpoint(http.MethodGet, postResource, encodePostGetListRequest,
+			func() interface{} { return &models.GetListResponse{} }),
+	}, nil
+}
+
+func (c *postClient) Create(ctx context.Context, data models.CreateRequest) (string, error) {
+	res, err := c.create(ctx, data)
+	if err != nil {
+		return "", err
+	}
+	return res.(*models.CreateResponse).ID, nil
+}
+
+func (c *postClient) GetOne(ctx context.Context, data models.IdRequest) (*models.Post, error) {
+	res, err := c.getOne(ctx, data)
+	if err != nil {
+		return nil, err
+	}
+	return res.(*models.GetOneResponse).Post, nil
+}
+
+func (c *postClient) Update(ctx context.Context, data models.UpdateRequest) error {
+	_, err := c.update(ctx, data)
+	return err
+}
+
+func (c *postClient) Delete(ctx context.Context, data models.IdRequest) error {
+	_, err := c.delete(ctx, data)
+	return err
+}
+
+func (c *postClient) Reorder(ctx context.Context, data models.ReorderRequest) error {
+	_, err := c.reorder(ctx, data)
+	return err
+}
+
+func (c *postClient) GetList(ctx context.Context, data models.GetListRequest) ([]*models.Post, models.Pagination, error) {
+	res, err := c.getList(ctx, data)
+	if err != nil {
+		return nil, models.Pagination{}, err
+	}
+	list := res.(*models.GetListResponse)
+	return list.PostList, list.Pagination, nil
+}
+
+// CountByCategory has no route of its own, the counts are served along with
+// every page of the list.
+func (c *postClient) CountByCategory(ctx context.Context, data models.PostFilter) (map[string]int64, error) {
+	res, err := c.getList(ctx, models.GetListRequest{
+		ReqPagination: models.ReqPagination{Limit: 1},
+		PostFilter:    data,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res.(*models.GetListResponse).CategoryCounts, nil
+}
+
+func encodePostIdRequest(_ context.Context, r *http.Request, request interface{}) error {
+	appendPath(r, request.(models.IdRequest).ID)
+	return nil
+}
+
+func encodePostUpdateRequest(_ context.Context, r *http.Request, request interface{}) error {
+	req := request.(models.UpdateRequest)
+	appendPath(r, req.ID)
+	return setJSONBody(r, req)
+}
+
+func encodeReorderRequest(_ context.Context, r *http.Request, request interface{}) error {
+	appendPath(r, "reorder")
+	return setJSONBody(r, request)
+}
+
+func encodePostGetListRequest(_ context.Context, r *http.Request, request interface{}) error {
+	req := request.(models.GetListRequest)
+	setQuery(r, req.Limit, req.Offset, map[string]string{
+		"content_id":  req.ContentId,
+		"category_id": req.CategoryId,
+	})
+	return nil
+}
@@ -0,0 +1,54 @@
+package client
+
+import (
+	"context"
+	"github.com/SpawNKZ/content_service/common/auth"
+	"github.com/SpawNKZ/content_service/post/models"
+	postService "github.com/SpawNKZ/content_service/post/service"
+	post "github.com/SpawNKZ/content_service/post/transports"
+	"github.com/go-kit/log"
+	"reflect"
+	"testing"
+)
+
+func TestPostRoutes(t *testing.T) {
+	const postId = "64b000000000000000000021"
+	svc := &recordingPostService{}
+	paths := &pathRecorder{handler: post.MakeHTTPHandler(svc, auth.NewParser(secret), log.NewNopLogger())}
+	c, err := NewPostClient(serve(t, paths), signedIn(t, Config{}))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	reorder := models.ReorderRequest{ContentId: contentId, PostIds: []string{postId}}
+	if err := c.Reorder(context.Background(), reorder); err != nil {
+		t.Fatal(err)
+	}
+	if err := c.Delete(context.Background(), models.IdRequest{ID: postId}); err != nil {
+		t.Fatal(err)
+	}
+
+	wantPaths := []string{"PUT /api/v1/post/reorder", "DELETE /api/v1/post/" + postId}
+	if !reflect.DeepEqual(paths.paths, wantPaths) {
+		t.Fatalf("requested %v, want %v", paths.paths, wantPaths)
+	}
+	if want := []interface{}{reorder, models.IdRequest{ID: postId}}; !reflect.DeepEqual(svc.calls, want) {
+		t.Fatalf("service got %+v, want %+v", svc.calls, want)
+	}
+}
+
+// recordingPostService records the requests it gets.
+type recordingPostService struct {
+	postService.Service
+	calls []interface{}
+}
+
+func (s *recordingPostService) Reorder(_ context.Context, data models.ReorderRequest) error {
+	s.calls = append(s.calls, data)
+	return nil
+}
+
+func (s *recordingPostService) Delete(_ context.Context, data models.IdRequest) error {
+	s.calls = append(s.calls, data)
+	return nil
+}
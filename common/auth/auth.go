@@ -0,0 +1,109 @@
+package auth
+
+import (
+	"context"
+	"github.com/SpawNKZ/content_service/common/errors"
+	kitJwt "github.com/go-kit/kit/auth/jwt"
+	"github.com/go-kit/kit/endpoint"
+	"github.com/golang-jwt/jwt/v4"
+)
+
+const (
+	GroupAdmin    = "admin"
+	GroupReviewer = "reviewer"
+	GroupAuthor   = "author"
+)
+
+const (
+	claimUserId = "sub"
+	claimGroups = "groups"
+)
+
+// Identity is the authenticated caller extracted from the JWT claims.
+type Identity struct {
+	UserId string
+	Groups []string
+}
+
+func (i *Identity) HasGroup(groups ...string) bool {
+	for _, g := range i.Groups {
+		for _, group := range groups {
+			if g == group {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func (i *Identity) IsAdmin() bool {
+	return i.HasGroup(GroupAdmin)
+}
+
+// FromContext returns the identity of the caller, if the request carried a valid token.
+func FromContext(ctx context.Context) (*Identity, bool) {
+	claims, ok := ctx.Value(kitJwt.JWTClaimsContextKey).(jwt.MapClaims)
+	if !ok {
+		return nil, false
+	}
+
+	userId, _ := claims[claimUserId].(string)
+	if userId == "" {
+		return nil, false
+	}
+
+	identity := &Identity{UserId: userId}
+	if groups, ok := claims[claimGroups].([]interface{}); ok {
+		for _, g := range groups {
+			if group, ok := g.(string); ok {
+				identity.Groups = append(identity.Groups, group)
+			}
+		}
+	}
+
+	return identity, true
+}
+
+// NewParser validates the token put into the context by jwt.HTTPToContext.
+// Requests without a token are passed through anonymously, so that the
+// service layer decides which operations require an identity.
+func NewParser(secret string) endpoint.Middleware {
+	keyFunc := func(token *jwt.Token) (interface{}, error) {
+		return []byte(secret), nil
+	}
+	parser := kitJwt.NewParser(keyFunc, jwt.SigningMethodHS256, kitJwt.MapClaimsFactory)
+
+	return func(next endpoint.Endpoint) endpoint.Endpoint {
+		parsed := parser(next)
+		return func(ctx context.Context, request interface{}) (response interface{}, err error) {
+			if token, ok := ctx.Value(kitJwt.JWTContextKey).(string); !ok || token == "" {
+				return next(ctx, request)
+			}
+
+			response, err = parsed(ctx, request)
+			switch err {
+			case kitJwt.ErrTokenInvalid, kitJwt.ErrTokenExpired, kitJwt.ErrTokenMalformed,
+				kitJwt.ErrTokenNotActive, kitJwt.ErrUnexpectedSigningMethod:
+				return nil, errors.ErrUnauthorized
+			}
+			return response, err
+		}
+	}
+}
+
+// RequireGroups refuses the requests of anonymous callers and of callers in
+// none of the groups. It goes after the middleware returned by NewParser.
+func RequireGroups(groups ...string) endpoint.Middleware {
+	return func(next endpoint.Endpoint) endpoint.Endpoint {
+		return func(ctx context.Context, request interface{}) (interface{}, error) {
+			identity, ok := FromContext(ctx)
+			if !ok {
+				return nil, errors.ErrUnauthorized
+			}
+			if !identity.HasGroup(groups...) {
+				return nil, errors.ErrForbidden
+			}
+			return next(ctx, request)
+		}
+	}
+}
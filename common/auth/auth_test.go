@@ -0,0 +1,34 @@
+package auth
+
+import (
+	"context"
+	"github.com/SpawNKZ/content_service/common/errors"
+	kitJwt "github.com/go-kit/kit/auth/jwt"
+	"github.com/golang-jwt/jwt/v4"
+	"testing"
+)
+
+func TestRequireGroups(t *testing.T) {
+	ok := func(context.Context, interface{}) (interface{}, error) { return "ok", nil }
+	withGroups := func(groups ...interface{}) context.Context {
+		return context.WithValue(context.Background(), kitJwt.JWTClaimsContextKey, jwt.MapClaims{"sub": "user", "groups": groups})
+	}
+
+	tests := []struct {
+		name string
+		ctx  context.Context
+		want *errors.AppError
+	}{
+		{"anonymous", context.Background(), errors.ErrUnauthorized},
+		{"other group", withGroups(GroupAuthor), errors.ErrForbidden},
+		{"required group", withGroups(GroupAuthor, GroupAdmin), nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := RequireGroups(GroupAdmin)(ok)(tt.ctx, nil)
+			if tt.want == nil && err != nil || tt.want != nil && !errors.Is(err, tt.want) {
+				t.Fatalf("err = %v, want %v", err, tt.want)
+			}
+		})
+	}
+}
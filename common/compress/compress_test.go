@@ -0,0 +1,98 @@
+package compress
+
+import (
+	"compress/gzip"
+	"github.com/andybalholm/brotli"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+const body = `{"success":true,"data":{"id":"64b000000000000000000001"}}`
+
+func TestMiddleware(t *testing.T) {
+	tests := []struct {
+		name           string
+		method         string
+		acceptEncoding string
+		wantEncoding   string
+	}{
+		{"brotli", http.MethodGet, "br", "br"},
+		{"gzip", http.MethodGet, "gzip", "gzip"},
+		{"brotli on equal terms", http.MethodGet, "gzip, br", "br"},
+		{"preferred gzip", http.MethodGet, "br;q=0.5, gzip", "gzip"},
+		{"wildcard", http.MethodGet, "*", "br"},
+		{"identity", http.MethodGet, "identity", ""},
+		{"none", http.MethodGet, "", ""},
+		{"refused", http.MethodGet, "br;q=0, gzip;q=0", ""},
+		{"head", http.MethodHead, "gzip", ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var seenAcceptEncoding string
+			h := Middleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				seenAcceptEncoding = r.Header.Get("Accept-Encoding")
+				w.Header().Set("Content-Type", "application/json")
+				io.WriteString(w, body)
+			}))
+			r := httptest.NewRequest(tt.method, "/", nil)
+			r.Header.Set("Accept-Encoding", tt.acceptEncoding)
+			w := httptest.NewRecorder()
+
+			h.ServeHTTP(w, r)
+
+			if got := w.Header().Get("Content-Encoding"); got != tt.wantEncoding {
+				t.Fatalf("Content-Encoding = %q, want %q", got, tt.wantEncoding)
+			}
+			if w.Header().Get("Vary") != "Accept-Encoding" {
+				t.Fatalf("Vary = %q, want Accept-Encoding", w.Header().Get("Vary"))
+			}
+			if tt.wantEncoding != "" && seenAcceptEncoding != "" {
+				t.Fatalf("handler saw Accept-Encoding %q, want it removed", seenAcceptEncoding)
+			}
+			if tt.method == http.MethodHead {
+				return
+			}
+			if got := decode(t, tt.wantEncoding, w.Body); got != body {
+				t.Fatalf("body = %q, want %q", got, body)
+			}
+		})
+	}
+}
+
+func TestMiddlewareKeepsEncodedResponses(t *testing.T) {
+	h := Middleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Encoding", "gzip")
+		w.WriteHeader(http.StatusOK)
+		io.WriteString(w, "already compressed")
+	}))
+	r := httptest.NewRequest(http.MethodGet, "/", nil)
+	r.Header.Set("Accept-Encoding", "br")
+	w := httptest.NewRecorder()
+
+	h.ServeHTTP(w, r)
+
+	if w.Header().Get("Content-Encoding") != "gzip" || w.Body.String() != "already compressed" {
+		t.Fatalf("served %q %q, want the response as written", w.Header().Get("Content-Encoding"), w.Body.String())
+	}
+}
+
+func decode(t *testing.T, encoding string, r io.Reader) string {
+	switch encoding {
+	case "br":
+		r = brotli.NewReader(r)
+	case "gzip":
+		gz, err := gzip.NewReader(r)
+		if err != nil {
+			t.Fatal(err)
+		}
+		r = gz
+	}
+	var b strings.Builder
+	if _, err := io.Copy(&b, r); err != nil {
+		t.Fatal(err)
+	}
+	return b.String()
+}
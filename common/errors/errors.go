@@ -1,48 +1,177 @@
 package errors
 
 import (
+	"context"
+	"encoding/hex"
+	"encoding/json"
 	"errors"
-	"fmt"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"io"
 	"net/http"
 )
 
+// AppError is an error meant to be shown to the client: a stable
+// machine-readable code, a human-readable message and the HTTP status it is
+// served with. Details and Fields carry optional context, Err the underlying
+// cause which is logged but never exposed.
+type AppError struct {
+	Code    string
+	Message string
+	Status  int
+	Details map[string]interface{}
+	Fields  []FieldError
+	Err     error
+}
+
+// FieldError describes why a single field of the request is invalid.
+type FieldError struct {
+	Field   string `json:"field"`
+	Message string `json:"message"`
+}
+
+// New returns an application error with the given code, HTTP status and message.
+func New(code string, status int, message string) *AppError {
+	return &AppError{Code: code, Status: status, Message: message}
+}
+
+func (e *AppError) Error() string {
+	if e.Err != nil {
+		return e.Message + ": " + e.Err.Error()
+	}
+	return e.Message
+}
+
+func (e *AppError) Unwrap() error {
+	return e.Err
+}
+
+// Is reports two application errors as the same when they share a code, so
+// that copies made by Wrap, WithDetails and WithFields still match the
+// sentinel they were derived from.
+func (e *AppError) Is(target error) bool {
+	t, ok := target.(*AppError)
+	return ok && t.Code == e.Code
+}
+
+// Wrap returns a copy of the error caused by err.
+func (e *AppError) Wrap(err error) *AppError {
+	c := *e
+	c.Err = err
+	return &c
+}
+
+// WithMessage returns a copy of the error with another message.
+func (e *AppError) WithMessage(message string) *AppError {
+	c := *e
+	c.Message = message
+	return &c
+}
+
+// WithDetails returns a copy of the error carrying the given details.
+func (e *AppError) WithDetails(details map[string]interface{}) *AppError {
+	c := *e
+	c.Details = details
+	return &c
+}
+
+// WithFields returns a copy of the error carrying the given field errors.
+func (e *AppError) WithFields(fields ...FieldError) *AppError {
+	c := *e
+	c.Fields = fields
+	return &c
+}
+
 var (
-	ErrBadRouting            = errors.New("inconsistent mapping between route and handler")
-	ErrInconsistentIDs       = errors.New("inconsistent IDs")
-	ErrAlreadyExists         = errors.New("already exists")
-	ErrNotFound              = errors.New("object not found")
-	ErrDB                    = errors.New("db error")
-	ErrBadRequest            = errors.New("bad request")
-	ErrContentIsNotRemovable = errors.New("content is not removable")
-	ErrLimit                 = fmt.Errorf("limit should be greater than zero and less than")
-	ErrOffset                = fmt.Errorf("offset should be greater than or equal to zero and less than")
-	ErrInvalidLocale         = errors.New("invalid language code")
-	ErrInconsistentLocales   = errors.New("inconsistent locales")
-	ErrTranslationExists     = errors.New("duplicate translation")
-	ErrParsing               = errors.New("failed to parse")
-	ErrInvalidOrganizationID = errors.New("invalid Organization ID")
-	ErrInvalidCityID         = errors.New("invalid City ID")
-	ErrInvalidGradeNumber    = errors.New("invalid Grade Number")
-	ErrInvalidSchoolID       = errors.New("invalid School ID")
-	ErrInvalidGradeID        = errors.New("invalid Grade ID")
-	ErrInvalidClassID        = errors.New("invalid Class ID")
-	ErrInvalidSchoolYear     = errors.New("invalid School Year")
-	ErrInvalidLetter         = errors.New("invalid Letter")
-	ErrNoResponseFromSubject = errors.New("could not get response from the subject service, wrong response format")
+	ErrBadRouting            = New("bad_routing", http.StatusBadRequest, "inconsistent mapping between route and handler")
+	ErrInconsistentIDs       = New("inconsistent_ids", http.StatusBadRequest, "inconsistent IDs")
+	ErrAlreadyExists         = New("already_exists", http.StatusConflict, "already exists")
+	ErrNotFound              = New("not_found", http.StatusNotFound, "object not found")
+	ErrDB                    = New("db_error", http.StatusInternalServerError, "db error")
+	ErrBadRequest            = New("bad_request", http.StatusBadRequest, "bad request")
+	ErrContentIsNotRemovable = New("content_not_removable", http.StatusConflict, "content is not removable")
+	ErrLimit                 = New("invalid_limit", http.StatusBadRequest, "limit should be greater than zero and less than")
+	ErrOffset                = New("invalid_offset", http.StatusBadRequest, "offset should be greater than or equal to zero and less than")
+	ErrInvalidLocale         = New("invalid_locale", http.StatusBadRequest, "invalid language code")
+	ErrInconsistentLocales   = New("inconsistent_locales", http.StatusBadRequest, "inconsistent locales")
+	ErrTranslationExists     = New("duplicate_translation", http.StatusBadRequest, "duplicate translation")
+	ErrParsing               = New("parsing_failed", http.StatusBadRequest, "failed to parse")
+	ErrInvalidOrganizationID = New("invalid_organization_id", http.StatusBadRequest, "invalid Organization ID")
+	ErrInvalidCityID         = New("invalid_city_id", http.StatusBadRequest, "invalid City ID")
+	ErrInvalidGradeNumber    = New("invalid_grade_number", http.StatusBadRequest, "invalid Grade Number")
+	ErrInvalidSchoolID       = New("invalid_school_id", http.StatusBadRequest, "invalid School ID")
+	ErrInvalidGradeID        = New("invalid_grade_id", http.StatusBadRequest, "invalid Grade ID")
+	ErrInvalidClassID        = New("invalid_class_id", http.StatusBadRequest, "invalid Class ID")
+	ErrInvalidSchoolYear     = New("invalid_school_year", http.StatusBadRequest, "invalid School Year")
+	ErrInvalidLetter         = New("invalid_letter", http.StatusBadRequest, "invalid Letter")
+	ErrNoResponseFromSubject = New("subject_bad_response", http.StatusInternalServerError, "could not get response from the subject service, wrong response format")
+	ErrUnauthorized          = New("unauthorized", http.StatusUnauthorized, "authentication required")
+	ErrForbidden             = New("forbidden", http.StatusForbidden, "access denied")
+	ErrInvalidState          = New("invalid_state", http.StatusConflict, "invalid state transition")
+	ErrInvalidRange          = New("invalid_range", http.StatusBadRequest, "range is out of the content body")
+	ErrInvalidRole           = New("invalid_role", http.StatusBadRequest, "invalid contributor role")
+	ErrInvalidCategory       = New("invalid_category", http.StatusBadRequest, "invalid post category")
+	ErrSubjectUnavailable    = New("subject_unavailable", http.StatusServiceUnavailable, "subject service is unavailable")
+	ErrInvalidID             = New("invalid_id", http.StatusBadRequest, "invalid object ID")
+	ErrInvalidBody           = New("invalid_body", http.StatusBadRequest, "malformed request body")
+	ErrTimeout               = New("timeout", http.StatusGatewayTimeout, "request timed out")
+	ErrNotAcceptable         = New("not_acceptable", http.StatusNotAcceptable, "none of the accepted media types can be produced")
+	ErrValidation            = New("validation_failed", http.StatusUnprocessableEntity, "request validation failed")
+	ErrInternal              = New("internal", http.StatusInternalServerError, "internal error")
+	ErrSystemStatus          = New("system_status", http.StatusConflict, "system statuses cannot be renamed or deleted")
+	ErrStatusInUse           = New("status_in_use", http.StatusConflict, "status is used by content")
+	ErrCategoryInUse         = New("category_in_use", http.StatusConflict, "category is used by posts")
 )
 
-func ErrorToHttpCode(err error) int {
-	switch err {
-	case ErrBadRouting, ErrInconsistentIDs, ErrLimit, ErrOffset, ErrInvalidLocale, ErrInconsistentLocales,
-		ErrTranslationExists, ErrParsing, ErrInvalidOrganizationID, ErrInvalidCityID, ErrInvalidGradeNumber,
-		ErrInvalidSchoolID, ErrInvalidGradeID, ErrInvalidSchoolYear:
-		return http.StatusBadRequest
-	case ErrNotFound:
-		return http.StatusNotFound
+// FromError turns any error into an application error. Errors of the
+// libraries the service relies on are mapped to their application
+// counterpart, anything else becomes an internal error whose message is
+// not disclosed to the client.
+func FromError(err error) *AppError {
+	var appErr *AppError
+	var syntaxErr *json.SyntaxError
+	var typeErr *json.UnmarshalTypeError
+	var hexErr hex.InvalidByteError
+
+	switch {
+	case errors.As(err, &appErr):
+		return appErr
+	case errors.Is(err, primitive.ErrInvalidHex), errors.As(err, &hexErr):
+		return ErrInvalidID.Wrap(err)
+	case errors.Is(err, mongo.ErrNoDocuments):
+		return ErrNotFound.Wrap(err)
+	case errors.As(err, &syntaxErr), errors.As(err, &typeErr), errors.Is(err, io.EOF), errors.Is(err, io.ErrUnexpectedEOF):
+		return ErrInvalidBody.Wrap(err)
+	case errors.Is(err, context.DeadlineExceeded):
+		return ErrTimeout.Wrap(err)
 	}
-	return http.StatusInternalServerError
+	return ErrInternal.Wrap(err)
+}
+
+func ErrorToHttpCode(err error) int {
+	return FromError(err).Status
 }
 
 func Is(err error, target error) bool {
 	return errors.Is(err, target)
 }
+
+// Body is the JSON representation of an application error.
+type Body struct {
+	Code      string                 `json:"code"`
+	Message   string                 `json:"message"`
+	Details   map[string]interface{} `json:"details,omitempty"`
+	Fields    []FieldError           `json:"fields,omitempty"`
+	RequestId string                 `json:"request_id,omitempty"`
+}
+
+// ToBody returns the error as served to the client.
+func (e *AppError) ToBody(requestId string) *Body {
+	return &Body{
+		Code:      e.Code,
+		Message:   e.Message,
+		Details:   e.Details,
+		Fields:    e.Fields,
+		RequestId: requestId,
+	}
+}
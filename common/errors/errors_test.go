@@ -0,0 +1,49 @@
+package errors
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"io"
+	"net/http"
+	"testing"
+)
+
+func TestFromError(t *testing.T) {
+	_, shortHex := primitive.ObjectIDFromHex("abc")
+	_, nonHex := primitive.ObjectIDFromHex("zzzzzzzzzzzzzzzzzzzzzzzz")
+
+	tests := []struct {
+		name       string
+		err        error
+		wantCode   string
+		wantStatus int
+	}{
+		{"application error", ErrForbidden.WithMessage("not yours"), "forbidden", http.StatusForbidden},
+		{"wrapped application error", fmt.Errorf("update: %w", ErrNotFound), "not_found", http.StatusNotFound},
+		{"short object ID", shortHex, "invalid_id", http.StatusBadRequest},
+		{"non-hex object ID", nonHex, "invalid_id", http.StatusBadRequest},
+		{"wrapped object ID", ErrInvalidID.Wrap(nonHex), "invalid_id", http.StatusBadRequest},
+		{"no documents", mongo.ErrNoDocuments, "not_found", http.StatusNotFound},
+		{"malformed JSON", &json.SyntaxError{Offset: 1}, "invalid_body", http.StatusBadRequest},
+		{"wrong JSON type", &json.UnmarshalTypeError{Value: "string"}, "invalid_body", http.StatusBadRequest},
+		{"empty body", io.EOF, "invalid_body", http.StatusBadRequest},
+		{"truncated body", io.ErrUnexpectedEOF, "invalid_body", http.StatusBadRequest},
+		{"deadline", context.DeadlineExceeded, "timeout", http.StatusGatewayTimeout},
+		{"anything else", errors.New("boom"), "internal", http.StatusInternalServerError},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := FromError(tt.err)
+			if got.Code != tt.wantCode || got.Status != tt.wantStatus {
+				t.Fatalf("FromError(%v) = %s %d, want %s %d", tt.err, got.Code, got.Status, tt.wantCode, tt.wantStatus)
+			}
+			if tt.wantCode == "internal" && got.Message != ErrInternal.Message {
+				t.Fatalf("internal error discloses %q", got.Message)
+			}
+		})
+	}
+}
@@ -0,0 +1,123 @@
+package health
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"github.com/nats-io/nats.go"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/readpref"
+	"net/http"
+	"sync"
+	"time"
+)
+
+const (
+	StatusUp   = "up"
+	StatusDown = "down"
+)
+
+var errNotConnected = errors.New("not connected")
+
+// Component is a dependency checked by the readiness probe.
+// The service is not ready while a required component is down.
+type Component struct {
+	Name     string
+	Required bool
+	Check    func(ctx context.Context) error
+}
+
+type ComponentReport struct {
+	Status   string `json:"status"`
+	Required bool   `json:"required"`
+	Error    string `json:"error,omitempty"`
+}
+
+type Report struct {
+	Status     string                     `json:"status"`
+	Components map[string]ComponentReport `json:"components,omitempty"`
+}
+
+// LivenessHandler reports that the process is running and able to serve HTTP.
+func LivenessHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		writeReport(w, http.StatusOK, Report{Status: StatusUp})
+	})
+}
+
+// ReadinessHandler checks every component concurrently within timeout and
+// answers 503 when a required one is down.
+func ReadinessHandler(timeout time.Duration, components ...Component) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx, cancel := context.WithTimeout(r.Context(), timeout)
+		defer cancel()
+
+		report := Check(ctx, components...)
+
+		code := http.StatusOK
+		if report.Status != StatusUp {
+			code = http.StatusServiceUnavailable
+		}
+		writeReport(w, code, report)
+	})
+}
+
+func Check(ctx context.Context, components ...Component) Report {
+	report := Report{
+		Status:     StatusUp,
+		Components: make(map[string]ComponentReport, len(components)),
+	}
+
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	for _, component := range components {
+		wg.Add(1)
+		go func(component Component) {
+			defer wg.Done()
+
+			componentReport := ComponentReport{Status: StatusUp, Required: component.Required}
+			if err := component.Check(ctx); err != nil {
+				componentReport.Status = StatusDown
+				componentReport.Error = err.Error()
+			}
+
+			mu.Lock()
+			defer mu.Unlock()
+			report.Components[component.Name] = componentReport
+			if componentReport.Status == StatusDown && component.Required {
+				report.Status = StatusDown
+			}
+		}(component)
+	}
+	wg.Wait()
+
+	return report
+}
+
+func MongoCheck(client *mongo.Client) func(ctx context.Context) error {
+	return func(ctx context.Context) error {
+		if client == nil {
+			return errNotConnected
+		}
+		return client.Ping(ctx, readpref.Primary())
+	}
+}
+
+func NATSCheck(nc *nats.Conn) func(ctx context.Context) error {
+	return func(ctx context.Context) error {
+		if nc == nil {
+			return errNotConnected
+		}
+		if status := nc.Status(); status != nats.CONNECTED {
+			return errors.New(status.String())
+		}
+		return nil
+	}
+}
+
+func writeReport(w http.ResponseWriter, code int, report Report) {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.Header().Set("Cache-Control", "no-store")
+	w.WriteHeader(code)
+	json.NewEncoder(w).Encode(report)
+}
@@ -0,0 +1,63 @@
+package instrumenting
+
+import (
+	"github.com/go-kit/kit/metrics"
+	kitPrometheus "github.com/go-kit/kit/metrics/prometheus"
+	stdPrometheus "github.com/prometheus/client_golang/prometheus"
+	"time"
+)
+
+const namespace = "content_service"
+
+// RequestMetrics holds the per method metrics of a service.
+type RequestMetrics struct {
+	RequestCount   metrics.Counter
+	RequestLatency metrics.Histogram
+	ErrorCount     metrics.Counter
+}
+
+func NewRequestMetrics(subsystem string) RequestMetrics {
+	fieldKeys := []string{"method"}
+
+	return RequestMetrics{
+		RequestCount: kitPrometheus.NewCounterFrom(stdPrometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: subsystem,
+			Name:      "request_count",
+			Help:      "Number of requests received.",
+		}, fieldKeys),
+		RequestLatency: kitPrometheus.NewHistogramFrom(stdPrometheus.HistogramOpts{
+			Namespace: namespace,
+			Subsystem: subsystem,
+			Name:      "request_latency_seconds",
+			Help:      "Total duration of requests in seconds.",
+			Buckets:   stdPrometheus.DefBuckets,
+		}, fieldKeys),
+		ErrorCount: kitPrometheus.NewCounterFrom(stdPrometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: subsystem,
+			Name:      "error_count",
+			Help:      "Number of requests that failed.",
+		}, fieldKeys),
+	}
+}
+
+// Observe records a call of the method that started at begin.
+func (m RequestMetrics) Observe(method string, begin time.Time, err error) {
+	m.RequestCount.With("method", method).Add(1)
+	m.RequestLatency.With("method", method).Observe(time.Since(begin).Seconds())
+	if err != nil {
+		m.ErrorCount.With("method", method).Add(1)
+	}
+}
+
+// NewLatencyHistogram creates a histogram of call durations labelled by fieldKeys.
+func NewLatencyHistogram(subsystem string, name string, help string, fieldKeys ...string) metrics.Histogram {
+	return kitPrometheus.NewHistogramFrom(stdPrometheus.HistogramOpts{
+		Namespace: namespace,
+		Subsystem: subsystem,
+		Name:      name,
+		Help:      help,
+		Buckets:   stdPrometheus.DefBuckets,
+	}, fieldKeys)
+}
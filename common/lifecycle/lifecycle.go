@@ -0,0 +1,91 @@
+package lifecycle
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/go-kit/log"
+	"os"
+	"os/signal"
+	"sync/atomic"
+	"syscall"
+	"time"
+)
+
+var ErrShuttingDown = errors.New("shutting down")
+
+type hook struct {
+	name string
+	fn   func(ctx context.Context) error
+}
+
+// Manager waits for a termination signal, reports not ready for the drain
+// delay and then stops the registered components in registration order, all
+// within a single deadline.
+type Manager struct {
+	logger       log.Logger
+	drainDelay   time.Duration
+	timeout      time.Duration
+	hooks        []hook
+	shuttingDown atomic.Bool
+}
+
+func New(logger log.Logger, drainDelay time.Duration, timeout time.Duration) *Manager {
+	return &Manager{
+		logger:     logger,
+		drainDelay: drainDelay,
+		timeout:    timeout,
+	}
+}
+
+// OnShutdown registers fn to be called on shutdown. Components are stopped in the
+// order they were registered, so register the ones accepting work first.
+func (m *Manager) OnShutdown(name string, fn func(ctx context.Context) error) {
+	m.hooks = append(m.hooks, hook{name: name, fn: fn})
+}
+
+// Check fails once shutdown has started, so that readiness probes stop routing traffic.
+func (m *Manager) Check(context.Context) error {
+	if m.shuttingDown.Load() {
+		return ErrShuttingDown
+	}
+	return nil
+}
+
+// Wait blocks until SIGINT or SIGTERM is received or a component reports a fatal
+// error on errs, then shuts everything down and returns the reason.
+func (m *Manager) Wait(errs <-chan error) error {
+	signals := make(chan os.Signal, 1)
+	signal.Notify(signals, syscall.SIGINT, syscall.SIGTERM)
+	defer signal.Stop(signals)
+
+	var reason error
+	select {
+	case sig := <-signals:
+		reason = fmt.Errorf("%s", sig)
+	case err := <-errs:
+		reason = err
+	}
+
+	m.logger.Log("msg", "shutting down", "reason", reason, "drain_delay", m.drainDelay, "timeout", m.timeout)
+	m.Shutdown()
+
+	return reason
+}
+
+// Shutdown fails the readiness check, waits for the drain delay so that the
+// probes notice it, then calls every registered hook, even if a previous one
+// failed.
+func (m *Manager) Shutdown() {
+	m.shuttingDown.Store(true)
+	time.Sleep(m.drainDelay)
+
+	ctx, cancel := context.WithTimeout(context.Background(), m.timeout)
+	defer cancel()
+
+	for _, h := range m.hooks {
+		begin := time.Now()
+		err := h.fn(ctx)
+		m.logger.Log("hook", h.name, "msg", "stopped", "took", time.Since(begin), "err", err)
+	}
+}
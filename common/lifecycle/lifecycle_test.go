@@ -0,0 +1,32 @@
+package lifecycle
+
+import (
+	"context"
+	"errors"
+	"github.com/go-kit/log"
+	"testing"
+	"time"
+)
+
+func TestShutdownDrainsBeforeStopping(t *testing.T) {
+	m := New(log.NewNopLogger(), 50*time.Millisecond, time.Second)
+
+	var notReadyFor time.Duration
+	begin := time.Now()
+	m.OnShutdown("server", func(context.Context) error {
+		if !errors.Is(m.Check(context.Background()), ErrShuttingDown) {
+			t.Error("server stopped while still reported as ready")
+		}
+		notReadyFor = time.Since(begin)
+		return nil
+	})
+
+	if err := m.Check(context.Background()); err != nil {
+		t.Fatalf("Check() = %v before shutdown", err)
+	}
+	m.Shutdown()
+
+	if notReadyFor < 50*time.Millisecond {
+		t.Fatalf("server stopped after %s, want at least the 50ms drain delay", notReadyFor)
+	}
+}
@@ -0,0 +1,206 @@
+package openapi
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/SpawNKZ/content_service/common/errors"
+	"github.com/SpawNKZ/content_service/common/pagination"
+	"github.com/SpawNKZ/content_service/common/transport"
+	"net/http"
+	"sort"
+	"strings"
+)
+
+const Version = "3.0.3"
+
+// Param is a path or query parameter of an operation.
+type Param struct {
+	Name        string
+	In          string
+	Type        string
+	Description string
+	Required    bool
+}
+
+func PathParam(name string) Param {
+	return Param{Name: name, In: "path", Type: "string", Required: true}
+}
+
+func QueryParam(name string, typ string, description string) Param {
+	return Param{Name: name, In: "query", Type: typ, Description: description}
+}
+
+// PaginationParams are the query parameters read by transport.Pagination.
+func PaginationParams() []Param {
+	return []Param{
+		QueryParam("limit", "integer", fmt.Sprintf("Page size, %d by default and at most %d.", pagination.DefaultLimit, pagination.MaxLimit)),
+		QueryParam("offset", "integer", "Number of items to skip."),
+	}
+}
+
+// Operation describes a route registered by a MakeHTTPHandler function.
+// Request is the body decoded by the route, nil when it reads none, and
+// Response is the data put in the envelope.
+type Operation struct {
+	Method   string
+	Path     string
+	Tag      string
+	Summary  string
+	Params   []Param
+	Request  interface{}
+	Response interface{}
+	Secured  bool
+}
+
+// Document is the OpenAPI document of the service.
+type Document struct {
+	Title      string
+	Version    string
+	Operations []Operation
+}
+
+func NewDocument(title string, version string, operations ...[]Operation) *Document {
+	d := &Document{Title: title, Version: version}
+	for _, ops := range operations {
+		d.Operations = append(d.Operations, ops...)
+	}
+	return d
+}
+
+func (d *Document) MarshalJSON() ([]byte, error) {
+	return json.Marshal(d.Spec())
+}
+
+// Spec builds the document as a JSON object.
+func (d *Document) Spec() Schema {
+	paths := Schema{}
+	var tags []string
+	seenTags := map[string]bool{}
+	for _, op := range d.Operations {
+		item, ok := paths[op.Path].(Schema)
+		if !ok {
+			item = Schema{}
+			paths[op.Path] = item
+		}
+		item[strings.ToLower(op.Method)] = operationSpec(op)
+
+		if op.Tag != "" && !seenTags[op.Tag] {
+			seenTags[op.Tag] = true
+			tags = append(tags, op.Tag)
+		}
+	}
+	sort.Strings(tags)
+
+	tagList := make([]Schema, 0, len(tags))
+	for _, tag := range tags {
+		tagList = append(tagList, Schema{"name": tag})
+	}
+
+	return Schema{
+		"openapi": Version,
+		"info":    Schema{"title": d.Title, "version": d.Version},
+		"tags":    tagList,
+		"paths":   paths,
+		"components": Schema{
+			"schemas": Schema{
+				"Error": SchemaOf(errors.Body{}),
+			},
+			"securitySchemes": Schema{
+				"bearerAuth": Schema{"type": "http", "scheme": "bearer", "bearerFormat": "JWT"},
+			},
+		},
+	}
+}
+
+func operationSpec(op Operation) Schema {
+	s := Schema{
+		"summary":     op.Summary,
+		"operationId": operationId(op),
+		"responses": Schema{
+			"200": Schema{
+				"description": "Success.",
+				"content":     mediaTypes(envelope(SchemaOf(op.Response), true)),
+			},
+			"default": Schema{
+				"description": "Error.",
+				"content":     mediaTypes(envelope(nil, false)),
+			},
+		},
+	}
+	if op.Tag != "" {
+		s["tags"] = []string{op.Tag}
+	}
+	if len(op.Params) > 0 {
+		params := make([]Schema, 0, len(op.Params))
+		for _, p := range op.Params {
+			param := Schema{
+				"name":     p.Name,
+				"in":       p.In,
+				"required": p.Required,
+				"schema":   Schema{"type": p.Type},
+			}
+			if p.Description != "" {
+				param["description"] = p.Description
+			}
+			params = append(params, param)
+		}
+		s["parameters"] = params
+	}
+	if op.Request != nil {
+		s["requestBody"] = Schema{
+			"required": true,
+			"content":  Schema{transport.ContentTypeJSON: Schema{"schema": SchemaOf(op.Request)}},
+		}
+	}
+	if op.Secured {
+		s["security"] = []Schema{{"bearerAuth": []string{}}}
+	}
+	return s
+}
+
+// envelope is the schema of transport.OperationResult carrying data on
+// success and the error otherwise.
+func envelope(data Schema, success bool) Schema {
+	properties := Schema{
+		"success": Schema{"type": "boolean"},
+		"message": Schema{"type": "string"},
+	}
+	if success {
+		properties["data"] = data
+	} else {
+		properties["error"] = Schema{"$ref": "#/components/schemas/Error"}
+	}
+	return Schema{"type": "object", "properties": properties}
+}
+
+func mediaTypes(schema Schema) Schema {
+	return Schema{
+		transport.ContentTypeJSON:    Schema{"schema": schema},
+		transport.ContentTypeMsgpack: Schema{"schema": schema},
+	}
+}
+
+func operationId(op Operation) string {
+	id := strings.ToLower(op.Method)
+	for _, part := range strings.Split(op.Path, "/") {
+		part = strings.Trim(part, "{}")
+		if part == "" || part == "api" || part == "v1" {
+			continue
+		}
+		id += "_" + strings.ReplaceAll(part, "-", "_")
+	}
+	return id
+}
+
+// Handler serves the document as JSON.
+func Handler(d *Document) http.Handler {
+	body, err := json.Marshal(d)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		w.Header().Set("Content-Type", transport.ContentTypeJSON)
+		w.Write(body)
+	})
+}
@@ -0,0 +1,140 @@
+package openapi
+
+import (
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Schema is a JSON schema object of the document.
+type Schema map[string]interface{}
+
+var (
+	timeType  = reflect.TypeOf(time.Time{})
+	errorType = reflect.TypeOf((*error)(nil)).Elem()
+)
+
+// SchemaOf derives the schema of v from its Go type and its json and validate
+// tags. Struct fields without a json tag are left out: the transports fill
+// them from the path or the service does, they are never read from the body.
+func SchemaOf(v interface{}) Schema {
+	if v == nil {
+		return Schema{}
+	}
+	return schemaOf(reflect.TypeOf(v), map[reflect.Type]bool{})
+}
+
+func schemaOf(t reflect.Type, seen map[reflect.Type]bool) Schema {
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	if t == timeType {
+		return Schema{"type": "string", "format": "date-time"}
+	}
+
+	switch t.Kind() {
+	case reflect.String:
+		return Schema{"type": "string"}
+	case reflect.Bool:
+		return Schema{"type": "boolean"}
+	case reflect.Int8, reflect.Int16, reflect.Int32, reflect.Uint8, reflect.Uint16, reflect.Uint32:
+		return Schema{"type": "integer", "format": "int32"}
+	case reflect.Int, reflect.Int64, reflect.Uint, reflect.Uint64:
+		return Schema{"type": "integer", "format": "int64"}
+	case reflect.Float32, reflect.Float64:
+		return Schema{"type": "number"}
+	case reflect.Slice, reflect.Array:
+		if t.Elem().Kind() == reflect.Uint8 {
+			return Schema{"type": "string", "format": "byte"}
+		}
+		return Schema{"type": "array", "items": schemaOf(t.Elem(), seen)}
+	case reflect.Map:
+		return Schema{"type": "object", "additionalProperties": schemaOf(t.Elem(), seen)}
+	case reflect.Struct:
+		if seen[t] {
+			return Schema{"type": "object"}
+		}
+		seen[t] = true
+		defer delete(seen, t)
+
+		properties := Schema{}
+		var required []string
+		addFields(t, properties, &required, seen)
+
+		s := Schema{"type": "object", "properties": properties}
+		if len(required) > 0 {
+			s["required"] = required
+		}
+		return s
+	}
+	return Schema{}
+}
+
+func addFields(t reflect.Type, properties Schema, required *[]string, seen map[reflect.Type]bool) {
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		if f.Type == errorType {
+			continue
+		}
+		tag, hasTag := f.Tag.Lookup("json")
+		name := strings.Split(tag, ",")[0]
+
+		if f.Anonymous && name == "" {
+			embedded := f.Type
+			if embedded.Kind() == reflect.Ptr {
+				embedded = embedded.Elem()
+			}
+			if embedded.Kind() == reflect.Struct {
+				addFields(embedded, properties, required, seen)
+			}
+			continue
+		}
+		if !f.IsExported() || !hasTag || name == "-" {
+			continue
+		}
+		if name == "" {
+			name = f.Name
+		}
+
+		s := schemaOf(f.Type, seen)
+		if applyValidation(s, f.Tag.Get("validate")) {
+			*required = append(*required, name)
+		}
+		properties[name] = s
+	}
+}
+
+// applyValidation adds the bounds of the validate tag to s and reports
+// whether the field is required. Rules after "dive" apply to the elements and
+// are left out.
+func applyValidation(s Schema, tag string) (required bool) {
+	for _, rule := range strings.Split(tag, ",") {
+		if rule == "dive" {
+			break
+		}
+		key, value, _ := strings.Cut(rule, "=")
+		if key == "required" {
+			required = true
+			continue
+		}
+		n, err := strconv.Atoi(value)
+		if err != nil || (key != "min" && key != "max") {
+			continue
+		}
+
+		switch s["type"] {
+		case "string":
+			s[key+"Length"] = n
+		case "array":
+			s[key+"Items"] = n
+		case "integer", "number":
+			if key == "min" {
+				s["minimum"] = n
+			} else {
+				s["maximum"] = n
+			}
+		}
+	}
+	return required
+}
@@ -0,0 +1,20 @@
+package openapi
+
+import (
+	_ "embed"
+	"html/template"
+	"net/http"
+)
+
+//go:embed swagger-ui.html
+var swaggerUI string
+
+var swaggerUITemplate = template.Must(template.New("swagger-ui").Parse(swaggerUI))
+
+// UIHandler serves Swagger UI rendering the document found at specURL.
+func UIHandler(specURL string) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/html; charset=utf-8")
+		swaggerUITemplate.Execute(w, struct{ SpecURL string }{specURL})
+	})
+}
@@ -0,0 +1,63 @@
+package openapi
+
+import (
+	"fmt"
+	"github.com/gorilla/mux"
+	"net/http"
+	"sort"
+	"strings"
+)
+
+// Verify checks that the operations describe exactly the routes registered on
+// the router built by a MakeHTTPHandler function.
+func Verify(h http.Handler, operations []Operation) error {
+	router, ok := h.(*mux.Router)
+	if !ok {
+		return fmt.Errorf("openapi: cannot list the routes of %T", h)
+	}
+
+	registered := map[string]bool{}
+	err := router.Walk(func(route *mux.Route, _ *mux.Router, _ []*mux.Route) error {
+		path, err := route.GetPathTemplate()
+		if err != nil {
+			return nil
+		}
+		methods, err := route.GetMethods()
+		if err != nil {
+			return nil
+		}
+		for _, method := range methods {
+			registered[routeKey(method, path)] = true
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	documented := map[string]bool{}
+	for _, op := range operations {
+		documented[routeKey(op.Method, op.Path)] = true
+	}
+
+	var problems []string
+	for key := range registered {
+		if !documented[key] {
+			problems = append(problems, "undocumented route "+key)
+		}
+	}
+	for key := range documented {
+		if !registered[key] {
+			problems = append(problems, "documented route "+key+" is not registered")
+		}
+	}
+	if len(problems) > 0 {
+		sort.Strings(problems)
+		return fmt.Errorf("openapi: %s", strings.Join(problems, "; "))
+	}
+	return nil
+}
+
+func routeKey(method string, path string) string {
+	return strings.ToUpper(method) + " " + path
+}
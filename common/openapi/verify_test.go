@@ -0,0 +1,33 @@
+package openapi
+
+import (
+	"github.com/gorilla/mux"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestVerify(t *testing.T) {
+	r := mux.NewRouter()
+	sr := r.PathPrefix("/api/v1/thing").Subrouter()
+	sr.Methods("GET").Path("").Handler(http.NotFoundHandler())
+	sr.Methods("DELETE").Path("/{id}").Handler(http.NotFoundHandler())
+
+	listed := Operation{Method: "GET", Path: "/api/v1/thing"}
+	deleted := Operation{Method: "DELETE", Path: "/api/v1/thing/{id}"}
+	created := Operation{Method: "POST", Path: "/api/v1/thing"}
+
+	if err := Verify(r, []Operation{listed, deleted}); err != nil {
+		t.Fatalf("Verify() = %v, want nil", err)
+	}
+
+	err := Verify(r, []Operation{listed, created})
+	if err == nil {
+		t.Fatal("Verify() = nil, want the mismatches")
+	}
+	for _, want := range []string{"undocumented route DELETE /api/v1/thing/{id}", "documented route POST /api/v1/thing is not registered"} {
+		if !strings.Contains(err.Error(), want) {
+			t.Errorf("Verify() = %v, want it to report %q", err, want)
+		}
+	}
+}
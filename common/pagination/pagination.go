@@ -0,0 +1,63 @@
+package pagination
+
+import (
+	"fmt"
+	"github.com/SpawNKZ/content_service/common/errors"
+	"net/url"
+	"strconv"
+)
+
+const (
+	DefaultLimit = 10
+	MaxOffset    = 2147483647
+	MaxLimit     = 1000
+)
+
+// Normalize applies the default limit when none is given and caps it at
+// MaxLimit. Negative limits and offsets outside [0, MaxOffset) are rejected.
+func Normalize(limit int, offset int) (int, int, error) {
+	switch {
+	case limit < 0:
+		return 0, 0, ErrLimit()
+	case limit == 0:
+		limit = DefaultLimit
+	case limit > MaxLimit:
+		limit = MaxLimit
+	}
+
+	if offset < 0 || offset >= MaxOffset {
+		return 0, 0, ErrOffset()
+	}
+
+	return limit, offset, nil
+}
+
+// FromQuery reads the "limit" and "offset" query parameters, missing ones
+// are reported as zero.
+func FromQuery(query url.Values) (limit int, offset int, err error) {
+	if s := query.Get("limit"); s != "" {
+		if limit, err = strconv.Atoi(s); err != nil {
+			return 0, 0, ErrLimit()
+		}
+	}
+	if s := query.Get("offset"); s != "" {
+		if offset, err = strconv.Atoi(s); err != nil {
+			return 0, 0, ErrOffset()
+		}
+	}
+	return limit, offset, nil
+}
+
+// ErrLimit is errors.ErrLimit completed with the maximum limit.
+func ErrLimit() error {
+	return errors.ErrLimit.
+		WithMessage(fmt.Sprintf("%s %d", errors.ErrLimit.Message, MaxLimit)).
+		WithDetails(map[string]interface{}{"max": MaxLimit})
+}
+
+// ErrOffset is errors.ErrOffset completed with the maximum offset.
+func ErrOffset() error {
+	return errors.ErrOffset.
+		WithMessage(fmt.Sprintf("%s %d", errors.ErrOffset.Message, MaxOffset)).
+		WithDetails(map[string]interface{}{"max": MaxOffset})
+}
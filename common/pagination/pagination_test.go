@@ -0,0 +1,37 @@
+package pagination
+
+import (
+	"github.com/SpawNKZ/content_service/common/errors"
+	"testing"
+)
+
+func TestNormalize(t *testing.T) {
+	tests := []struct {
+		name                  string
+		limit, offset         int
+		wantLimit, wantOffset int
+		wantErr               *errors.AppError
+	}{
+		{name: "defaults", wantLimit: DefaultLimit},
+		{name: "given", limit: 20, offset: 40, wantLimit: 20, wantOffset: 40},
+		{name: "max limit", limit: MaxLimit, wantLimit: MaxLimit},
+		{name: "limit clamped", limit: MaxLimit + 1, offset: 5, wantLimit: MaxLimit, wantOffset: 5},
+		{name: "negative limit", limit: -1, wantErr: errors.ErrLimit},
+		{name: "negative offset", limit: 10, offset: -1, wantErr: errors.ErrOffset},
+		{name: "max offset", offset: MaxOffset, wantErr: errors.ErrOffset},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			limit, offset, err := Normalize(tt.limit, tt.offset)
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Fatalf("err = %v, want %s", err, tt.wantErr.Code)
+				}
+				return
+			}
+			if err != nil || limit != tt.wantLimit || offset != tt.wantOffset {
+				t.Fatalf("Normalize(%d, %d) = %d, %d, %v, want %d, %d", tt.limit, tt.offset, limit, offset, err, tt.wantLimit, tt.wantOffset)
+			}
+		})
+	}
+}
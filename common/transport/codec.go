@@ -0,0 +1,191 @@
+package transport
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/SpawNKZ/content_service/common/errors"
+	"github.com/SpawNKZ/content_service/pb"
+	httpTransport "github.com/go-kit/kit/transport/http"
+	"github.com/vmihailenco/msgpack/v5"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/structpb"
+	"io"
+	"mime"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+const (
+	ContentTypeJSON     = "application/json"
+	ContentTypeMsgpack  = "application/msgpack"
+	ContentTypeProtobuf = "application/x-protobuf"
+)
+
+// ProtoResponse is implemented by the responses that can be served as
+// protobuf, ToProto returns nil for responses without data.
+type ProtoResponse interface {
+	ToProto() proto.Message
+}
+
+// codec writes the envelope of a response in one media type.
+type codec struct {
+	contentType string
+	encode      func(w io.Writer, result *OperationResult) error
+}
+
+var (
+	jsonCodec = codec{
+		contentType: ContentTypeJSON + "; charset=utf-8",
+		encode: func(w io.Writer, result *OperationResult) error {
+			return json.NewEncoder(w).Encode(result)
+		},
+	}
+	msgpackCodec = codec{
+		contentType: ContentTypeMsgpack,
+		encode: func(w io.Writer, result *OperationResult) error {
+			enc := msgpack.NewEncoder(w)
+			// Keep the field names of the JSON representation.
+			enc.SetCustomStructTag("json")
+			return enc.Encode(result)
+		},
+	}
+	protobufCodec = codec{
+		contentType: ContentTypeProtobuf,
+		encode: func(w io.Writer, result *OperationResult) error {
+			message, err := result.toProto()
+			if err != nil {
+				return err
+			}
+			b, err := proto.Marshal(message)
+			if err != nil {
+				return err
+			}
+			_, err = w.Write(b)
+			return err
+		},
+	}
+)
+
+// aliases maps the accepted names of the supported media types to their codec.
+var aliases = map[string]*codec{
+	ContentTypeJSON:                   &jsonCodec,
+	ContentTypeMsgpack:                &msgpackCodec,
+	"application/x-msgpack":           &msgpackCodec,
+	ContentTypeProtobuf:               &protobufCodec,
+	"application/protobuf":            &protobufCodec,
+	"application/vnd.google.protobuf": &protobufCodec,
+	"application/*":                   &jsonCodec,
+	"*/*":                             &jsonCodec,
+}
+
+// negotiate picks the codec of the preferred media type of the Accept header
+// able to encode the response. JSON is used when the client has no
+// preference, ErrNotAcceptable is returned when nothing it accepts can be
+// produced.
+func negotiate(ctx context.Context, response interface{}) (*codec, error) {
+	accept, _ := ctx.Value(httpTransport.ContextKeyRequestAccept).(string)
+	if strings.TrimSpace(accept) == "" {
+		return &jsonCodec, nil
+	}
+
+	for _, mediaType := range parseAccept(accept) {
+		c, ok := aliases[mediaType]
+		if !ok {
+			continue
+		}
+		if c == &protobufCodec {
+			if _, ok := response.(ProtoResponse); !ok && response != nil {
+				continue
+			}
+		}
+		return c, nil
+	}
+	return nil, errors.ErrNotAcceptable
+}
+
+// parseAccept returns the media types of the Accept header, most preferred
+// first, leaving out the ones with a zero quality.
+func parseAccept(accept string) []string {
+	type preference struct {
+		mediaType string
+		quality   float64
+	}
+
+	var preferences []preference
+	for _, part := range strings.Split(accept, ",") {
+		mediaType, params, err := mime.ParseMediaType(strings.TrimSpace(part))
+		if err != nil {
+			continue
+		}
+		quality := 1.0
+		if q, ok := params["q"]; ok {
+			if quality, err = strconv.ParseFloat(q, 64); err != nil {
+				continue
+			}
+		}
+		if quality > 0 {
+			preferences = append(preferences, preference{mediaType, quality})
+		}
+	}
+
+	sort.SliceStable(preferences, func(i, j int) bool {
+		return preferences[i].quality > preferences[j].quality
+	})
+
+	mediaTypes := make([]string, 0, len(preferences))
+	for _, p := range preferences {
+		mediaTypes = append(mediaTypes, p.mediaType)
+	}
+	return mediaTypes
+}
+
+func (o *OperationResult) toProto() (*pb.OperationResult, error) {
+	result := &pb.OperationResult{
+		Success: o.Success,
+		Message: o.Message,
+	}
+
+	if data, ok := o.Data.(ProtoResponse); ok {
+		if message := data.ToProto(); message != nil {
+			a, err := anypb.New(message)
+			if err != nil {
+				return nil, err
+			}
+			result.Data = a
+		}
+	}
+
+	if o.Failure != nil {
+		failure, err := failureToProto(o.Failure)
+		if err != nil {
+			return nil, err
+		}
+		result.Error = failure
+	}
+
+	return result, nil
+}
+
+func failureToProto(body *errors.Body) (*pb.Error, error) {
+	failure := &pb.Error{
+		Code:      body.Code,
+		Message:   body.Message,
+		RequestId: body.RequestId,
+	}
+
+	if body.Details != nil {
+		details, err := structpb.NewStruct(body.Details)
+		if err != nil {
+			return nil, err
+		}
+		failure.Details = details
+	}
+
+	for _, field := range body.Fields {
+		failure.Fields = append(failure.Fields, &pb.FieldError{Field: field.Field, Message: field.Message})
+	}
+
+	return failure, nil
+}
@@ -0,0 +1,117 @@
+package transport
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"github.com/SpawNKZ/content_service/common/errors"
+	"github.com/SpawNKZ/content_service/pb"
+	httpTransport "github.com/go-kit/kit/transport/http"
+	"github.com/vmihailenco/msgpack/v5"
+	"google.golang.org/protobuf/proto"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+type plainResponse struct {
+	ID string `json:"id"`
+}
+
+type protoResponse struct {
+	ID string `json:"id"`
+}
+
+func (r protoResponse) ToProto() proto.Message {
+	return &pb.Error{Code: r.ID}
+}
+
+func TestEncodeResponseNegotiation(t *testing.T) {
+	tests := []struct {
+		name            string
+		accept          string
+		response        interface{}
+		wantStatus      int
+		wantContentType string
+	}{
+		{"no preference", "", plainResponse{ID: "1"}, http.StatusOK, jsonCodec.contentType},
+		{"any", "*/*", plainResponse{ID: "1"}, http.StatusOK, jsonCodec.contentType},
+		{"msgpack", "application/msgpack", plainResponse{ID: "1"}, http.StatusOK, ContentTypeMsgpack},
+		{"msgpack alias", "application/x-msgpack", plainResponse{ID: "1"}, http.StatusOK, ContentTypeMsgpack},
+		{"quality order", "application/json;q=0.5, application/msgpack", plainResponse{ID: "1"}, http.StatusOK, ContentTypeMsgpack},
+		{"refused type", "application/msgpack;q=0, application/json", plainResponse{ID: "1"}, http.StatusOK, jsonCodec.contentType},
+		{"protobuf", "application/x-protobuf", protoResponse{ID: "1"}, http.StatusOK, ContentTypeProtobuf},
+		{"protobuf unsupported", "application/x-protobuf, application/json;q=0.1", plainResponse{ID: "1"}, http.StatusOK, jsonCodec.contentType},
+		{"not acceptable", "text/html", plainResponse{ID: "1"}, http.StatusNotAcceptable, jsonCodec.contentType},
+		// The error envelope itself can be served as protobuf.
+		{"protobuf only", "application/x-protobuf", plainResponse{ID: "1"}, http.StatusNotAcceptable, ContentTypeProtobuf},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.WithValue(context.Background(), httpTransport.ContextKeyRequestAccept, tt.accept)
+			w := httptest.NewRecorder()
+
+			if err := EncodeResponse(ctx, w, tt.response); err != nil {
+				t.Fatal(err)
+			}
+			if w.Code != tt.wantStatus || w.Header().Get("Content-Type") != tt.wantContentType {
+				t.Fatalf("served %d %q, want %d %q", w.Code, w.Header().Get("Content-Type"), tt.wantStatus, tt.wantContentType)
+			}
+		})
+	}
+}
+
+func TestEncodeResponseBodies(t *testing.T) {
+	encode := func(accept string, response interface{}) *bytes.Buffer {
+		ctx := context.WithValue(context.Background(), httpTransport.ContextKeyRequestAccept, accept)
+		w := httptest.NewRecorder()
+		if err := EncodeResponse(ctx, w, response); err != nil {
+			t.Fatal(err)
+		}
+		return w.Body
+	}
+
+	var jsonResult struct {
+		Success bool          `json:"success"`
+		Data    plainResponse `json:"data"`
+	}
+	if err := json.NewDecoder(encode("", plainResponse{ID: "1"})).Decode(&jsonResult); err != nil {
+		t.Fatal(err)
+	}
+	if !jsonResult.Success || jsonResult.Data.ID != "1" {
+		t.Fatalf("JSON = %+v, want the response in a successful envelope", jsonResult)
+	}
+
+	// msgpack keeps the field names of the JSON representation.
+	var msgpackResult map[string]interface{}
+	if err := msgpack.NewDecoder(encode(ContentTypeMsgpack, plainResponse{ID: "1"})).Decode(&msgpackResult); err != nil {
+		t.Fatal(err)
+	}
+	if data, ok := msgpackResult["data"].(map[string]interface{}); msgpackResult["success"] != true || !ok || data["id"] != "1" {
+		t.Fatalf("msgpack = %v, want the response in a successful envelope", msgpackResult)
+	}
+
+	var protoResult pb.OperationResult
+	if err := proto.Unmarshal(encode(ContentTypeProtobuf, protoResponse{ID: "1"}).Bytes(), &protoResult); err != nil {
+		t.Fatal(err)
+	}
+	var data pb.Error
+	if err := protoResult.GetData().UnmarshalTo(&data); !protoResult.Success || err != nil || data.Code != "1" {
+		t.Fatalf("protobuf = %v, want the response in a successful envelope", &protoResult)
+	}
+}
+
+func TestErrorEncoderFallsBackToJSON(t *testing.T) {
+	ctx := context.WithValue(context.Background(), httpTransport.ContextKeyRequestAccept, "text/html")
+	w := httptest.NewRecorder()
+
+	ErrorEncoder(ctx, errors.ErrNotFound, w)
+
+	var result OperationResult
+	if err := json.NewDecoder(w.Body).Decode(&result); err != nil {
+		t.Fatal(err)
+	}
+	if w.Code != http.StatusNotFound || result.Failure == nil || result.Failure.Code != errors.ErrNotFound.Code {
+		t.Fatalf("served %d %+v, want %s in JSON", w.Code, result, errors.ErrNotFound.Code)
+	}
+}
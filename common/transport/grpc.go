@@ -0,0 +1,93 @@
+package transport
+
+import (
+	"context"
+	"github.com/SpawNKZ/content_service/common/errors"
+	"github.com/SpawNKZ/content_service/common/requestid"
+	"github.com/go-kit/kit/auth/jwt"
+	"github.com/go-kit/kit/endpoint"
+	kitTransport "github.com/go-kit/kit/transport"
+	grpcTransport "github.com/go-kit/kit/transport/grpc"
+	"github.com/go-kit/log"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"net/http"
+	"strings"
+)
+
+// GRPCServerOptions are the options shared by the gRPC servers of all
+// resources, the counterpart of ServerOptions.
+func GRPCServerOptions(logger log.Logger) []grpcTransport.ServerOption {
+	return []grpcTransport.ServerOption{
+		grpcTransport.ServerErrorHandler(kitTransport.NewLogErrorHandler(logger)),
+		grpcTransport.ServerBefore(jwt.GRPCToContext(), grpcRequestId),
+	}
+}
+
+// EncodeGRPCResponse turns business-logic errors into errors of the call and
+// responses into their protobuf message.
+func EncodeGRPCResponse(_ context.Context, response interface{}) (interface{}, error) {
+	if e, ok := response.(endpoint.Failer); ok && e.Failed() != nil {
+		return nil, e.Failed()
+	}
+	if r, ok := response.(ProtoResponse); ok {
+		if message := r.ToProto(); message != nil {
+			return message, nil
+		}
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// GRPCError converts an error into a gRPC status carrying the error body,
+// with its code and field errors, as details.
+func GRPCError(ctx context.Context, err error) error {
+	appErr := errors.FromError(err)
+	code := grpcCode(appErr.Status)
+	if errors.Is(appErr, errors.ErrAlreadyExists) {
+		code = codes.AlreadyExists
+	}
+	st := status.New(code, appErr.Message)
+
+	failure, convErr := failureToProto(appErr.ToBody(requestid.FromContext(ctx)))
+	if convErr != nil {
+		return st.Err()
+	}
+	if withDetails, detailsErr := st.WithDetails(failure); detailsErr == nil {
+		st = withDetails
+	}
+	return st.Err()
+}
+
+func grpcCode(httpStatus int) codes.Code {
+	switch httpStatus {
+	case http.StatusBadRequest, http.StatusUnprocessableEntity, http.StatusNotAcceptable:
+		return codes.InvalidArgument
+	case http.StatusUnauthorized:
+		return codes.Unauthenticated
+	case http.StatusForbidden:
+		return codes.PermissionDenied
+	case http.StatusNotFound:
+		return codes.NotFound
+	case http.StatusConflict:
+		return codes.FailedPrecondition
+	case http.StatusServiceUnavailable:
+		return codes.Unavailable
+	case http.StatusGatewayTimeout:
+		return codes.DeadlineExceeded
+	}
+	return codes.Internal
+}
+
+// grpcRequestId takes the request ID from the call metadata, or makes one.
+func grpcRequestId(ctx context.Context, md metadata.MD) context.Context {
+	id := ""
+	if ids := md.Get(strings.ToLower(requestid.Header)); len(ids) > 0 {
+		id = ids[0]
+	}
+	if id == "" {
+		id = requestid.New()
+	}
+	return requestid.NewContext(ctx, id)
+}
@@ -0,0 +1,104 @@
+package transport
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/SpawNKZ/content_service/common/errors"
+	"github.com/SpawNKZ/content_service/common/pagination"
+	"github.com/SpawNKZ/content_service/common/requestid"
+	"github.com/SpawNKZ/content_service/common/tracing"
+	"github.com/go-kit/kit/auth/jwt"
+	"github.com/go-kit/kit/endpoint"
+	kitTransport "github.com/go-kit/kit/transport"
+	httpTransport "github.com/go-kit/kit/transport/http"
+	"github.com/go-kit/log"
+	"github.com/gorilla/mux"
+	"net/http"
+)
+
+// OperationResult is the envelope of every HTTP response. A new one is built
+// for each response, it is never shared between requests.
+type OperationResult struct {
+	Success bool         `json:"success"`
+	Message string       `json:"message"`
+	Data    interface{}  `json:"data"`
+	Failure *errors.Body `json:"error,omitempty"`
+}
+
+func Succeed(data interface{}) *OperationResult {
+	return &OperationResult{
+		Success: true,
+		Data:    data,
+	}
+}
+
+func Fail(err *errors.AppError, requestId string) *OperationResult {
+	return &OperationResult{
+		Success: false,
+		Message: err.Message,
+		Failure: err.ToBody(requestId),
+	}
+}
+
+// ServerOptions are the options shared by the HTTP servers of all resources:
+// errors are logged and encoded in the envelope, the request and its bearer
+// token are put in the context and the request is traced.
+func ServerOptions(logger log.Logger) []httpTransport.ServerOption {
+	options := []httpTransport.ServerOption{
+		httpTransport.ServerErrorHandler(kitTransport.NewLogErrorHandler(logger)),
+		httpTransport.ServerErrorEncoder(ErrorEncoder),
+		httpTransport.ServerBefore(httpTransport.PopulateRequestContext, jwt.HTTPToContext()),
+	}
+	return append(options, tracing.HTTPServerOptions()...)
+}
+
+func EncodeResponse(ctx context.Context, w http.ResponseWriter, response interface{}) error {
+	if e, ok := response.(endpoint.Failer); ok && e.Failed() != nil {
+		// Not a Go kit transports error, but a business-logic error.
+		// Provide those as HTTP errors.
+		ErrorEncoder(ctx, e.Failed(), w)
+		return nil
+	}
+
+	c, err := negotiate(ctx, response)
+	if err != nil {
+		ErrorEncoder(ctx, err, w)
+		return nil
+	}
+	w.Header().Add("Vary", "Accept")
+	w.Header().Set("Content-Type", c.contentType)
+	return c.encode(w, Succeed(response))
+}
+
+// ErrorEncoder writes the error in the envelope, in the media type asked by
+// the client when possible and in JSON otherwise.
+func ErrorEncoder(ctx context.Context, err error, w http.ResponseWriter) {
+	appErr := errors.FromError(err)
+	c, negotiateErr := negotiate(ctx, nil)
+	if negotiateErr != nil {
+		c = &jsonCodec
+	}
+	w.Header().Add("Vary", "Accept")
+	w.Header().Set("Content-Type", c.contentType)
+	w.WriteHeader(appErr.Status)
+	c.encode(w, Fail(appErr, requestid.FromContext(ctx)))
+}
+
+// PathVar returns the named variable of the route path.
+func PathVar(r *http.Request, name string) (string, error) {
+	value, ok := mux.Vars(r)[name]
+	if !ok {
+		return "", errors.ErrBadRouting
+	}
+	return value, nil
+}
+
+// DecodeJSON decodes the JSON request body into v.
+func DecodeJSON(r *http.Request, v interface{}) error {
+	return json.NewDecoder(r.Body).Decode(v)
+}
+
+// Pagination reads the limit and offset query parameters.
+func Pagination(r *http.Request) (limit int, offset int, err error) {
+	return pagination.FromQuery(r.URL.Query())
+}
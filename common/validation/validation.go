@@ -0,0 +1,130 @@
+package validation
+
+import (
+	"context"
+	stdErrors "errors"
+	"fmt"
+	"github.com/SpawNKZ/content_service/common/errors"
+	"github.com/go-kit/kit/endpoint"
+	"github.com/go-playground/validator/v10"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"reflect"
+	"strings"
+)
+
+// SupportedLocales are the language codes accepted by the "locale" rule.
+var SupportedLocales = []string{"kk", "ru", "en"}
+
+var validate = newValidator()
+
+func newValidator() *validator.Validate {
+	v := validator.New()
+
+	// Report fields under the name the client sent them with.
+	v.RegisterTagNameFunc(func(field reflect.StructField) string {
+		name := strings.SplitN(field.Tag.Get("json"), ",", 2)[0]
+		if name == "-" {
+			return ""
+		}
+		if name == "" {
+			return field.Name
+		}
+		return name
+	})
+
+	v.RegisterValidation("objectid", func(fl validator.FieldLevel) bool {
+		return primitive.IsValidObjectID(fl.Field().String())
+	})
+	v.RegisterValidation("locale", func(fl validator.FieldLevel) bool {
+		for _, locale := range SupportedLocales {
+			if fl.Field().String() == locale {
+				return true
+			}
+		}
+		return false
+	})
+
+	return v
+}
+
+// Validate checks the struct against the rules of its `validate` tags and
+// reports every broken rule as a field error.
+func Validate(request interface{}) error {
+	err := validate.Struct(request)
+
+	var invalid validator.ValidationErrors
+	if !stdErrors.As(err, &invalid) {
+		return nil
+	}
+
+	fields := make([]errors.FieldError, 0, len(invalid))
+	for _, fieldErr := range invalid {
+		fields = append(fields, errors.FieldError{
+			Field:   fieldPath(fieldErr),
+			Message: message(fieldErr),
+		})
+	}
+	return errors.ErrValidation.WithFields(fields...)
+}
+
+// Middleware rejects requests that do not pass Validate before they reach
+// the service.
+func Middleware() endpoint.Middleware {
+	return func(next endpoint.Endpoint) endpoint.Endpoint {
+		return func(ctx context.Context, request interface{}) (interface{}, error) {
+			if err := Validate(request); err != nil {
+				return nil, err
+			}
+			return next(ctx, request)
+		}
+	}
+}
+
+// fieldPath drops the name of the request struct from the namespace,
+// "CreateRequest.resources[0]" is reported as "resources[0]".
+func fieldPath(fieldErr validator.FieldError) string {
+	namespace := fieldErr.Namespace()
+	if i := strings.Index(namespace, "."); i >= 0 {
+		return namespace[i+1:]
+	}
+	return namespace
+}
+
+func message(fieldErr validator.FieldError) string {
+	// Alternatives, such as "hexcolor|len=0", are described by the first one.
+	switch strings.SplitN(fieldErr.Tag(), "|", 2)[0] {
+	case "required":
+		return "is required"
+	case "min":
+		if fieldErr.Kind() == reflect.String {
+			return fmt.Sprintf("should be at least %s characters long", fieldErr.Param())
+		}
+		if fieldErr.Kind() == reflect.Slice {
+			return fmt.Sprintf("should have at least %s elements", fieldErr.Param())
+		}
+		return fmt.Sprintf("should be greater than or equal to %s", fieldErr.Param())
+	case "max":
+		if fieldErr.Kind() == reflect.String {
+			return fmt.Sprintf("should be at most %s characters long", fieldErr.Param())
+		}
+		if fieldErr.Kind() == reflect.Slice {
+			return fmt.Sprintf("should have at most %s elements", fieldErr.Param())
+		}
+		return fmt.Sprintf("should be less than or equal to %s", fieldErr.Param())
+	case "gt":
+		return fmt.Sprintf("should be greater than %s", fieldErr.Param())
+	case "oneof":
+		return fmt.Sprintf("should be one of: %s", strings.ReplaceAll(fieldErr.Param(), " ", ", "))
+	case "url":
+		return "should be a valid URL"
+	case "objectid":
+		return "should be a valid object ID"
+	case "locale":
+		return fmt.Sprintf("should be one of: %s", strings.Join(SupportedLocales, ", "))
+	case "unique":
+		return "should not contain duplicates"
+	case "hexcolor":
+		return "should be a hex color such as #1e90ff"
+	}
+	return fmt.Sprintf("failed the %q rule", fieldErr.Tag())
+}
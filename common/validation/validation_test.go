@@ -0,0 +1,106 @@
+package validation
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/SpawNKZ/content_service/common/errors"
+	"github.com/SpawNKZ/content_service/common/transport"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+)
+
+type translation struct {
+	Locale string `json:"locale" validate:"required,locale"`
+}
+
+type request struct {
+	ID           string
+	Name         string        `json:"name" validate:"required,max=5"`
+	Difficulty   int           `json:"difficulty" validate:"min=0,max=10"`
+	StatusId     string        `json:"status_id" validate:"omitempty,objectid"`
+	Role         string        `json:"role" validate:"omitempty,oneof=author translator"`
+	Color        *string       `json:"color" validate:"omitempty,hexcolor|len=0"`
+	Translations []translation `json:"translations" validate:"unique=Locale,dive"`
+}
+
+func TestMiddleware(t *testing.T) {
+	next := func(context.Context, interface{}) (interface{}, error) { return "ok", nil }
+	color := "blue"
+
+	tests := []struct {
+		name       string
+		request    request
+		wantFields []errors.FieldError
+	}{
+		{
+			name:    "valid",
+			request: request{Name: "draft", Translations: []translation{{Locale: "ru"}, {Locale: "kk"}}},
+		},
+		{
+			name: "every broken rule",
+			request: request{
+				Name:         "archived",
+				Difficulty:   -1,
+				StatusId:     "draft",
+				Role:         "editor",
+				Color:        &color,
+				Translations: []translation{{Locale: "ru"}, {Locale: "de"}},
+			},
+			wantFields: []errors.FieldError{
+				{Field: "name", Message: "should be at most 5 characters long"},
+				{Field: "difficulty", Message: "should be greater than or equal to 0"},
+				{Field: "status_id", Message: "should be a valid object ID"},
+				{Field: "role", Message: "should be one of: author, translator"},
+				{Field: "color", Message: "should be a hex color such as #1e90ff"},
+				{Field: "translations[1].locale", Message: "should be one of: kk, ru, en"},
+			},
+		},
+		{
+			name:       "duplicates and missing fields",
+			request:    request{Translations: []translation{{Locale: "ru"}, {Locale: "ru"}}},
+			wantFields: []errors.FieldError{{Field: "name", Message: "is required"}, {Field: "translations", Message: "should not contain duplicates"}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			response, err := Middleware()(next)(context.Background(), tt.request)
+			if tt.wantFields == nil {
+				if err != nil || response != "ok" {
+					t.Fatalf("got %v, %v, want the request passed on", response, err)
+				}
+				return
+			}
+
+			appErr, ok := err.(*errors.AppError)
+			if !ok || !errors.Is(appErr, errors.ErrValidation) {
+				t.Fatalf("err = %v, want %s", err, errors.ErrValidation.Code)
+			}
+			if !reflect.DeepEqual(appErr.Fields, tt.wantFields) {
+				t.Fatalf("fields = %+v, want %+v", appErr.Fields, tt.wantFields)
+			}
+		})
+	}
+}
+
+// The field errors reach the client in the envelope, with a 422: the request
+// was well-formed but its values are not acceptable.
+func TestValidationErrorResponse(t *testing.T) {
+	err := Validate(request{Translations: []translation{}})
+
+	w := httptest.NewRecorder()
+	transport.ErrorEncoder(context.Background(), err, w)
+
+	if w.Code != http.StatusUnprocessableEntity {
+		t.Fatalf("status = %d, want %d", w.Code, http.StatusUnprocessableEntity)
+	}
+	var body transport.OperationResult
+	if err := json.NewDecoder(w.Body).Decode(&body); err != nil {
+		t.Fatal(err)
+	}
+	want := []errors.FieldError{{Field: "name", Message: "is required"}}
+	if body.Success || body.Failure == nil || body.Failure.Code != errors.ErrValidation.Code || !reflect.DeepEqual(body.Failure.Fields, want) {
+		t.Fatalf("body = %+v, want %s with the fields %+v", body, errors.ErrValidation.Code, want)
+	}
+}
@@ -39,6 +39,22 @@ func MakeAssignAuthorEndpoint(s contentService.Service) endpoint.Endpoint {
 	}
 }
 
+func MakeAddContributorEndpoint(s contentService.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (response interface{}, err error) {
+		req := request.(models.ContributorRequest)
+		e := s.AddContributor(ctx, req)
+		return models.UpdateResponse{Err: e}, nil
+	}
+}
+
+func MakeRemoveContributorEndpoint(s contentService.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (response interface{}, err error) {
+		req := request.(models.ContributorRequest)
+		e := s.RemoveContributor(ctx, req)
+		return models.UpdateResponse{Err: e}, nil
+	}
+}
+
 func MakeChangeStatusEndpoint(s contentService.Service) endpoint.Endpoint {
 	return func(ctx context.Context, request interface{}) (response interface{}, err error) {
 		req := request.(models.ChangeStatusRequest)
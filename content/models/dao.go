@@ -15,8 +15,14 @@ type ContentModel struct {
 	MicrotopicId int64              `bson:"microtopic_id"`
 	StatusId     string             `bson:"status_id"`
 	AuthorId     string             `bson:"author_id"`
+	Contributors []ContributorModel `bson:"contributors"`
 	Difficulty   int                `bson:"difficulty"`
 	CreatedAt    time.Time          `bson:"created_at"`
 	UpdatedAt    time.Time          `bson:"updated_at"`
 	DeletedAt    *time.Time         `bson:"deleted_at"`
 }
+
+type ContributorModel struct {
+	UserId string `bson:"user_id"`
+	Role   string `bson:"role"`
+}
@@ -16,6 +16,7 @@ func (ct *Content) ToRepositoryModel() *ContentModel {
 		MicrotopicId: ct.MicrotopicId,
 		StatusId:     ct.StatusId,
 		AuthorId:     ct.AuthorId,
+		Contributors: []ContributorModel{{UserId: ct.AuthorId, Role: RoleAuthor}},
 		Difficulty:   ct.Difficulty,
 		CreatedAt:    time.Now(),
 		UpdatedAt:    time.Now(),
@@ -32,6 +33,10 @@ func (ct *Content) FromRepositoryModel(repoCt *ContentModel) {
 	ct.MicrotopicId = repoCt.MicrotopicId
 	ct.StatusId = repoCt.StatusId
 	ct.AuthorId = repoCt.AuthorId
+	ct.Contributors = make([]*Contributor, 0, len(repoCt.Contributors))
+	for _, contributor := range repoCt.Contributors {
+		ct.Contributors = append(ct.Contributors, &Contributor{UserId: contributor.UserId, Role: contributor.Role})
+	}
 	ct.Difficulty = repoCt.Difficulty
 	ct.CreatedAt = repoCt.CreatedAt
 	ct.UpdatedAt = repoCt.UpdatedAt
@@ -58,8 +63,8 @@ func (ur *UpdateRequest) ToContent() map[string]interface{} {
 		args["description"] = ur.Description
 	}
 
-	if ur.Difficulty != 0 {
-		args["difficulty"] = ur.Difficulty
+	if ur.Difficulty != nil {
+		args["difficulty"] = *ur.Difficulty
 	}
 
 	if ur.Body != "" {
@@ -81,6 +86,18 @@ func (ur *AssignAuthorRequest) ToContent() map[string]interface{} {
 	return args
 }
 
+// ToContributor is the contributor entry of the new author.
+func (ur *AssignAuthorRequest) ToContributor() *ContributorRequest {
+	return &ContributorRequest{ID: ur.ID, UserId: ur.AuthorId, Role: RoleAuthor}
+}
+
+func (cr *ContributorRequest) ToRepositoryModel() ContributorModel {
+	return ContributorModel{
+		UserId: cr.UserId,
+		Role:   cr.Role,
+	}
+}
+
 func (ur *ChangeStatusRequest) ToContent() map[string]interface{} {
 	args := make(map[string]interface{})
 
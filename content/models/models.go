@@ -2,20 +2,57 @@ package models
 
 import "time"
 
+const (
+	RoleAuthor      = "author"
+	RoleTranslator  = "translator"
+	RoleReviewer    = "reviewer"
+	RoleIllustrator = "illustrator"
+)
+
 type Content struct {
-	ID           string    `json:"id"`
-	Locale       string    `json:"locale"`
-	Body         string    `json:"body"`
-	Description  string    `json:"description"`
-	Resources    []string  `json:"resources"`
-	SubjectId    int64     `json:"subject_id"`
-	Subject      *Subject  `json:"subject"`
-	MicrotopicId int64     `json:"microtopic_id"`
-	StatusId     string    `json:"status_id"`
-	AuthorId     string    `json:"author_id"`
-	Difficulty   int       `json:"difficulty"`
-	CreatedAt    time.Time `json:"created_at"`
-	UpdatedAt    time.Time `json:"updated_at"`
+	ID           string         `json:"id"`
+	Locale       string         `json:"locale"`
+	Body         string         `json:"body"`
+	Description  string         `json:"description"`
+	Resources    []string       `json:"resources"`
+	SubjectId    int64          `json:"subject_id"`
+	Subject      *Subject       `json:"subject,omitempty"`
+	MicrotopicId int64          `json:"microtopic_id"`
+	StatusId     string         `json:"status_id"`
+	Status       *Status        `json:"status,omitempty"`
+	AuthorId     string         `json:"author_id"`
+	Contributors []*Contributor `json:"contributors"`
+	Difficulty   int            `json:"difficulty"`
+	CreatedAt    time.Time      `json:"created_at"`
+	UpdatedAt    time.Time      `json:"updated_at"`
+	Warnings     []string       `json:"warnings,omitempty"`
+}
+
+const (
+	WarningSubjectOmitted = "subject service is unavailable, subject is omitted"
+	WarningSubjectStale   = "subject service is unavailable, subject may be outdated"
+)
+
+// Status is the status of the content as resolved from StatusId, absent when
+// the status no longer exists.
+type Status struct {
+	ID          string `json:"id"`
+	Name        string `json:"name"`
+	IsRemovable bool   `json:"is_removable"`
+	IsSystem    bool   `json:"is_system"`
+}
+
+type Contributor struct {
+	UserId string `json:"user_id"`
+	Role   string `json:"role"`
+}
+
+func IsValidRole(role string) bool {
+	switch role {
+	case RoleAuthor, RoleTranslator, RoleReviewer, RoleIllustrator:
+		return true
+	}
+	return false
 }
 
 type SubjectBase struct {
@@ -29,8 +66,8 @@ type SubjectBase struct {
 
 type Translation struct {
 	ID     int64  `json:"translationId,omitempty"`
-	Locale string `json:"locale" fake:"??"`
-	Name   string `json:"name"`
+	Locale string `json:"locale" fake:"??" validate:"required,locale"`
+	Name   string `json:"name" validate:"required,max=50"`
 }
 
 type Subject struct {
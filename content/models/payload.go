@@ -1,15 +1,15 @@
 package models
 
 type CreateRequest struct {
-	Locale       string   `json:"locale"`
-	Body         string   `json:"body"`
-	Description  string   `json:"description"`
-	Resources    []string `json:"resources"`
-	SubjectId    int64    `json:"subject_id"`
-	MicrotopicId int64    `json:"microtopic_id"`
+	Locale       string   `json:"locale" validate:"required,locale"`
+	Body         string   `json:"body" validate:"required,max=100000"`
+	Description  string   `json:"description" validate:"max=1000"`
+	Resources    []string `json:"resources" validate:"max=50,dive,url"`
+	SubjectId    int64    `json:"subject_id" validate:"gt=0"`
+	MicrotopicId int64    `json:"microtopic_id" validate:"gt=0"`
 	StatusId     string
-	AuthorId     string `json:"author_id"` //TODO: get from service
-	Difficulty   int    `json:"difficulty"`
+	AuthorId     string `json:"author_id" validate:"omitempty,max=64"` //TODO: get from service
+	Difficulty   int    `json:"difficulty" validate:"min=0,max=10"`
 }
 
 type IdRequest struct {
@@ -18,19 +18,26 @@ type IdRequest struct {
 
 type UpdateRequest struct {
 	ID          string
-	Body        string `json:"body"`
-	Description string `json:"description"`
-	Difficulty  int    `json:"difficulty"`
+	Body        string `json:"body" validate:"max=100000"`
+	Description string `json:"description" validate:"max=1000"`
+	// Difficulty is left unchanged when nil.
+	Difficulty *int `json:"difficulty" validate:"omitempty,min=0,max=10"`
 }
 
 type AssignAuthorRequest struct {
 	ID       string
-	AuthorId string `json:"author_id"`
+	AuthorId string `json:"author_id" validate:"required,max=64"`
+}
+
+type ContributorRequest struct {
+	ID     string
+	UserId string `json:"user_id" validate:"required,max=64"`
+	Role   string `json:"role" validate:"omitempty,oneof=author translator reviewer illustrator"`
 }
 
 type ChangeStatusRequest struct {
 	ID       string
-	StatusId string `json:"status_id"`
+	StatusId string `json:"status_id" validate:"required,objectid"`
 }
 
 type ReqPagination struct {
@@ -39,11 +46,12 @@ type ReqPagination struct {
 }
 
 type ContentFilter struct {
-	Locale       string `bson:"locale"`
-	Status       string `bson:"status"`
-	SubjectId    int64  `bson:"subject_id"`
-	MicrotopicId int64  `bson:"microtopic_id"`
-	AuthorId     string `bson:"author_id"`
+	Locale        string `bson:"locale"`
+	Status        string `bson:"status_id"`
+	SubjectId     int64  `bson:"subject_id"`
+	MicrotopicId  int64  `bson:"microtopic_id"`
+	AuthorId      string `bson:"author_id"`
+	ContributorId string `bson:"contributors.user_id"`
 }
 
 type GetListRequest struct {
@@ -0,0 +1,112 @@
+package models
+
+import (
+	"github.com/SpawNKZ/content_service/pb"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (ct *Content) ToProto() *pb.Content {
+	contributors := make([]*pb.Contributor, 0, len(ct.Contributors))
+	for _, contributor := range ct.Contributors {
+		contributors = append(contributors, &pb.Contributor{UserId: contributor.UserId, Role: contributor.Role})
+	}
+
+	return &pb.Content{
+		Id:           ct.ID,
+		Locale:       ct.Locale,
+		Body:         ct.Body,
+		Description:  ct.Description,
+		Resources:    ct.Resources,
+		SubjectId:    ct.SubjectId,
+		Subject:      ct.Subject.ToProto(),
+		MicrotopicId: ct.MicrotopicId,
+		StatusId:     ct.StatusId,
+		AuthorId:     ct.AuthorId,
+		Contributors: contributors,
+		Difficulty:   int32(ct.Difficulty),
+		CreatedAt:    timestamppb.New(ct.CreatedAt),
+		UpdatedAt:    timestamppb.New(ct.UpdatedAt),
+		Warnings:     ct.Warnings,
+		Status:       ct.Status.ToProto(),
+	}
+}
+
+func (s *Status) ToProto() *pb.ContentStatus {
+	if s == nil {
+		return nil
+	}
+
+	return &pb.ContentStatus{
+		Id:          s.ID,
+		Name:        s.Name,
+		IsRemovable: s.IsRemovable,
+		IsSystem:    s.IsSystem,
+	}
+}
+
+func (s *Subject) ToProto() *pb.Subject {
+	if s == nil {
+		return nil
+	}
+
+	translations := make([]*pb.Translation, 0, len(s.Translations))
+	for _, translation := range s.Translations {
+		translations = append(translations, translation.ToProto())
+	}
+
+	return &pb.Subject{
+		Id:           s.ID,
+		ProgramId:    s.ProgramID,
+		ImageUrl:     s.ImageUrl,
+		IconUrl:      s.IconUrl,
+		CreatedAt:    timestamppb.New(s.CreatedAt),
+		UpdatedAt:    timestamppb.New(s.UpdatedAt),
+		Translations: translations,
+	}
+}
+
+func (t *Translation) ToProto() *pb.Translation {
+	return &pb.Translation{
+		Id:     t.ID,
+		Locale: t.Locale,
+		Name:   t.Name,
+	}
+}
+
+func (p Pagination) ToProto() *pb.Pagination {
+	return &pb.Pagination{
+		Total:      int32(p.Total),
+		Limit:      int32(p.Limit),
+		Offset:     int32(p.Offset),
+		IsLastPage: p.IsLastPage,
+	}
+}
+
+func (r CreateResponse) ToProto() proto.Message {
+	return &pb.Created{Id: r.ID}
+}
+
+func (r GetOneResponse) ToProto() proto.Message {
+	return r.Content.ToProto()
+}
+
+func (r UpdateResponse) ToProto() proto.Message {
+	return nil
+}
+
+func (r DeleteOneResponse) ToProto() proto.Message {
+	return nil
+}
+
+func (r GetListResponse) ToProto() proto.Message {
+	contentList := make([]*pb.Content, 0, len(r.ContentList))
+	for _, contentObj := range r.ContentList {
+		contentList = append(contentList, contentObj.ToProto())
+	}
+
+	return &pb.ContentList{
+		Content:    contentList,
+		Pagination: r.Pagination.ToProto(),
+	}
+}
@@ -1,6 +1,7 @@
 package models
 
 import (
+	"github.com/SpawNKZ/content_service/common/pagination"
 	"github.com/go-kit/kit/endpoint"
 )
 
@@ -12,9 +13,9 @@ type Pagination struct {
 }
 
 const (
-	DefaultLimit = 10
-	MaxOffset    = 2147483647
-	MaxLimit     = 1000
+	DefaultLimit = pagination.DefaultLimit
+	MaxOffset    = pagination.MaxOffset
+	MaxLimit     = pagination.MaxLimit
 )
 
 var (
@@ -0,0 +1,51 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"github.com/SpawNKZ/content_service/content/models"
+	"github.com/go-kit/kit/metrics"
+	"time"
+)
+
+type instrumentingSubjectRepository struct {
+	latency metrics.Histogram
+	repo    SubjectRepository
+}
+
+// NewInstrumentingSubjectRepository records the latency of subjects.GetById NATS calls.
+func NewInstrumentingSubjectRepository(latency metrics.Histogram, repo SubjectRepository) SubjectRepository {
+	return &instrumentingSubjectRepository{
+		latency: latency,
+		repo:    repo,
+	}
+}
+
+func (r *instrumentingSubjectRepository) GetSubjectId(ctx context.Context, subjectId int64) (result *models.Subject, err error) {
+	defer func(begin time.Time) {
+		r.latency.With("subject", "subjects.GetById", "error", fmt.Sprint(err != nil)).Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return r.repo.GetSubjectId(ctx, subjectId)
+}
+
+type instrumentingMicrotopicRepository struct {
+	latency metrics.Histogram
+	repo    MicrotopicRepository
+}
+
+// NewInstrumentingMicrotopicRepository records the latency of microtopics.GetById NATS calls.
+func NewInstrumentingMicrotopicRepository(latency metrics.Histogram, repo MicrotopicRepository) MicrotopicRepository {
+	return &instrumentingMicrotopicRepository{
+		latency: latency,
+		repo:    repo,
+	}
+}
+
+func (r *instrumentingMicrotopicRepository) GetMicrotopicId(ctx context.Context, microtopicId int64) (result *models.Microtopic, err error) {
+	defer func(begin time.Time) {
+		r.latency.With("subject", "microtopics.GetById", "error", fmt.Sprint(err != nil)).Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return r.repo.GetMicrotopicId(ctx, microtopicId)
+}
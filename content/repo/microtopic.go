@@ -4,21 +4,41 @@ import (
 	"context"
 	"encoding/json"
 	"github.com/SpawNKZ/content_service/common/errors"
+	"github.com/SpawNKZ/content_service/common/tracing"
 	"github.com/SpawNKZ/content_service/content/models"
+	"github.com/SpawNKZ/content_service/mb"
+	"github.com/go-kit/kit/endpoint"
 	natsTransport "github.com/go-kit/kit/transport/nats"
+	"github.com/go-kit/log"
 	"github.com/nats-io/nats.go"
+	"go.opentelemetry.io/otel/attribute"
 )
 
 type microtopicRepository struct {
-	nc *nats.Conn
+	getById endpoint.Endpoint
 }
 
 type MicrotopicRepository interface {
 	GetMicrotopicId(ctx context.Context, subjectId int64) (*models.Microtopic, error)
 }
 
-func NewMicrotopicRepository(nc *nats.Conn) MicrotopicRepository {
-	return &microtopicRepository{nc: nc}
+func NewMicrotopicRepository(nc *nats.Conn, policy mb.RequestPolicy, logger log.Logger) MicrotopicRepository {
+	publisher := natsTransport.NewPublisher(
+		nc,
+		"microtopics.GetById",
+		natsTransport.EncodeJSONRequest,
+		decodeMicrotopicById,
+		natsTransport.PublisherBefore(tracing.NATSToHeader),
+		natsTransport.PublisherTimeout(policy.Timeout),
+	)
+
+	getById := mb.NewResilientMiddleware("microtopics.GetById", policy, logger)(publisher.Endpoint())
+	getById = tracing.ClientMiddleware("nats microtopics.GetById",
+		attribute.String("messaging.system", "nats"),
+		attribute.String("messaging.destination.name", "microtopics.GetById"),
+	)(getById)
+
+	return &microtopicRepository{getById: getById}
 }
 
 type getMicrotopicByIdRequest struct {
@@ -31,15 +51,11 @@ type getMicrotopicByIdResponse struct {
 }
 
 func (s *microtopicRepository) GetMicrotopicId(ctx context.Context, microtopicId int64) (*models.Microtopic, error) {
-	publisher := natsTransport.NewPublisher(
-		s.nc,
-		"microtopics.GetById",
-		natsTransport.EncodeJSONRequest,
-		decodeMicrotopicById,
-	)
-
-	res, err := publisher.Endpoint()(ctx, getMicrotopicByIdRequest{ID: microtopicId})
-	if err != nil {
+	res, err := s.getById(ctx, getMicrotopicByIdRequest{ID: microtopicId})
+	switch {
+	case mb.Unavailable(err):
+		return nil, errors.ErrSubjectUnavailable
+	case err != nil:
 		return nil, err
 	}
 
@@ -22,9 +22,14 @@ type Repository interface {
 	UpdateStatus(ctx context.Context, updateObj models.ChangeStatusRequest) error
 	Update(ctx context.Context, updateObj models.UpdateRequest) error
 	UpdateAuthor(ctx context.Context, updateObj models.AssignAuthorRequest) error
+	AddContributor(ctx context.Context, contributorObj models.ContributorRequest) error
+	RemoveContributor(ctx context.Context, contributorObj models.ContributorRequest) error
 	DeleteByID(ctx context.Context, id string) error
 	Count(ctx context.Context, filter models.ContentFilter) (int64, error)
 	GetAll(ctx context.Context, limit int64, offset int64, filter models.ContentFilter) ([]*models.Content, error)
+	CountByStatus(ctx context.Context, statusId string) (int64, error)
+	FindIdsByStatus(ctx context.Context, statusId string) ([]string, error)
+	ReplaceStatus(ctx context.Context, ids []string, fromStatusId string, toStatusId string) ([]string, error)
 }
 
 func NewRepository(client *mongo.Client) Repository {
@@ -47,7 +52,7 @@ func (r *repository) Insert(ctx context.Context, contentObj models.Content) (str
 func (r *repository) FindByID(ctx context.Context, id string) (*models.ContentModel, error) {
 	objectId, err := primitive.ObjectIDFromHex(id)
 	if err != nil {
-		return nil, err
+		return nil, errors.ErrInvalidID.Wrap(err)
 	}
 
 	filter := bson.D{{"_id", objectId}, {"deleted_at", nil}}
@@ -66,17 +71,7 @@ func (r *repository) FindByID(ctx context.Context, id string) (*models.ContentMo
 }
 
 func (r *repository) Count(ctx context.Context, filter models.ContentFilter) (int64, error) {
-	filterQuery := bson.M{"deleted_at": nil}
-	filterValue := reflect.ValueOf(filter)
-	filterType := reflect.TypeOf(filter)
-
-	for i := 0; i < filterValue.NumField(); i++ {
-		field := filterValue.Field(i)
-		if !field.IsZero() {
-			fieldName := filterType.Field(i).Tag.Get("bson")
-			filterQuery[fieldName] = field.Interface()
-		}
-	}
+	filterQuery := contentFilterQuery(filter)
 
 	count, err := r.c.CountDocuments(ctx, filterQuery)
 	if err != nil {
@@ -86,7 +81,9 @@ func (r *repository) Count(ctx context.Context, filter models.ContentFilter) (in
 	return count, nil
 }
 
-func (r *repository) GetAll(ctx context.Context, limit int64, offset int64, filter models.ContentFilter) ([]*models.Content, error) {
+// contentFilterQuery matches the content that is not deleted and has the
+// non-empty fields of filter, each field compared with its bson tag.
+func contentFilterQuery(filter models.ContentFilter) bson.M {
 	filterQuery := bson.M{"deleted_at": nil}
 	filterValue := reflect.ValueOf(filter)
 	filterType := reflect.TypeOf(filter)
@@ -98,6 +95,11 @@ func (r *repository) GetAll(ctx context.Context, limit int64, offset int64, filt
 			filterQuery[fieldName] = field.Interface()
 		}
 	}
+	return filterQuery
+}
+
+func (r *repository) GetAll(ctx context.Context, limit int64, offset int64, filter models.ContentFilter) ([]*models.Content, error) {
+	filterQuery := contentFilterQuery(filter)
 
 	options := options.Find()
 	options.SetSkip(offset)
@@ -136,7 +138,7 @@ func (r *repository) GetAll(ctx context.Context, limit int64, offset int64, filt
 func (r *repository) Update(ctx context.Context, updateObj models.UpdateRequest) error {
 	objectId, err := primitive.ObjectIDFromHex(updateObj.ID)
 	if err != nil {
-		return err
+		return errors.ErrInvalidID.Wrap(err)
 	}
 	filter := bson.D{{"_id", objectId}}
 
@@ -157,25 +159,96 @@ func (r *repository) Update(ctx context.Context, updateObj models.UpdateRequest)
 	return nil
 }
 
+// UpdateAuthor sets the author and, in the same update, replaces the author
+// entry of the outgoing author with one of the new author. Co-authors added
+// as contributors are kept.
 func (r *repository) UpdateAuthor(ctx context.Context, updateObj models.AssignAuthorRequest) error {
 	objectId, err := primitive.ObjectIDFromHex(updateObj.ID)
+	if err != nil {
+		return errors.ErrInvalidID.Wrap(err)
+	}
+	filter := bson.M{"_id": objectId, "deleted_at": nil}
+
+	// The update is a pipeline, the values are wrapped in $literal so that
+	// none is read as a field path.
+	set := bson.M{}
+	for key, value := range updateObj.ToContent() {
+		set[key] = bson.M{"$literal": value}
+	}
+	set["contributors"] = bson.M{"$concatArrays": bson.A{
+		bson.M{"$filter": bson.M{
+			"input": bson.M{"$ifNull": bson.A{"$contributors", bson.A{}}},
+			"as":    "contributor",
+			"cond":  notAuthorEntry("$author_id", bson.M{"$literal": updateObj.AuthorId}),
+		}},
+		bson.A{bson.M{"$literal": updateObj.ToContributor().ToRepositoryModel()}},
+	}}
+
+	updateResult, err := r.c.UpdateOne(ctx, filter, bson.A{bson.M{"$set": set}})
 	if err != nil {
 		return err
 	}
-	filter := bson.D{{"_id", objectId}}
+	if updateResult.MatchedCount == 0 {
+		return errors.ErrNotFound
+	}
+	return nil
+}
 
-	updateMap := updateObj.ToContent()
+// notAuthorEntry is a $filter condition keeping the contributors unless they
+// have the author role and one of the userIds expressions as user. Field
+// paths in a pipeline $set read the document before the update, so
+// "$author_id" is the outgoing author.
+func notAuthorEntry(userIds ...interface{}) bson.M {
+	return bson.M{"$not": bson.A{bson.M{"$and": bson.A{
+		bson.M{"$eq": bson.A{"$$contributor.role", models.RoleAuthor}},
+		bson.M{"$in": bson.A{"$$contributor.user_id", bson.A(userIds)}},
+	}}}}
+}
 
-	var update bson.D
-	for key, value := range updateMap {
-		update = append(update, bson.E{Key: key, Value: value})
+func (r *repository) AddContributor(ctx context.Context, contributorObj models.ContributorRequest) error {
+	objectId, err := primitive.ObjectIDFromHex(contributorObj.ID)
+	if err != nil {
+		return errors.ErrInvalidID.Wrap(err)
 	}
+	filter := bson.M{"_id": objectId, "deleted_at": nil}
 
-	updateResult, err := r.c.UpdateOne(ctx, filter, bson.D{{"$set", update}})
+	update := bson.M{
+		"$addToSet": bson.M{"contributors": contributorObj.ToRepositoryModel()},
+		"$set":      bson.M{"updated_at": time.Now()},
+	}
+
+	updateResult, err := r.c.UpdateOne(ctx, filter, update)
 	if err != nil {
 		return err
 	}
-	if updateResult.ModifiedCount == 0 {
+	if updateResult.MatchedCount == 0 {
+		return errors.ErrNotFound
+	}
+	return nil
+}
+
+func (r *repository) RemoveContributor(ctx context.Context, contributorObj models.ContributorRequest) error {
+	objectId, err := primitive.ObjectIDFromHex(contributorObj.ID)
+	if err != nil {
+		return errors.ErrInvalidID.Wrap(err)
+	}
+	filter := bson.M{"_id": objectId, "deleted_at": nil}
+
+	contributor := bson.M{"user_id": contributorObj.UserId}
+	if contributorObj.Role != "" {
+		contributor["role"] = contributorObj.Role
+	}
+
+	update := bson.M{
+		"$pull": bson.M{"contributors": contributor},
+		"$set":  bson.M{"updated_at": time.Now()},
+	}
+
+	updateResult, err := r.c.UpdateOne(ctx, filter, update)
+	if err != nil {
+		return err
+	}
+	if updateResult.MatchedCount == 0 {
 		return errors.ErrNotFound
 	}
 	return nil
@@ -184,7 +257,7 @@ func (r *repository) UpdateAuthor(ctx context.Context, updateObj models.AssignAu
 func (r *repository) DeleteByID(ctx context.Context, id string) error {
 	objectId, err := primitive.ObjectIDFromHex(id)
 	if err != nil {
-		return err
+		return errors.ErrInvalidID.Wrap(err)
 	}
 	filter := bson.D{{"_id", objectId}}
 
@@ -201,7 +274,7 @@ func (r *repository) DeleteByID(ctx context.Context, id string) error {
 func (r *repository) UpdateStatus(ctx context.Context, updateObj models.ChangeStatusRequest) error {
 	objectId, err := primitive.ObjectIDFromHex(updateObj.ID)
 	if err != nil {
-		return err
+		return errors.ErrInvalidID.Wrap(err)
 	}
 	filter := bson.D{{"_id", objectId}}
 
@@ -221,3 +294,57 @@ func (r *repository) UpdateStatus(ctx context.Context, updateObj models.ChangeSt
 	}
 	return nil
 }
+
+func (r *repository) CountByStatus(ctx context.Context, statusId string) (int64, error) {
+	return r.c.CountDocuments(ctx, bson.M{"deleted_at": nil, "status_id": statusId})
+}
+
+func (r *repository) FindIdsByStatus(ctx context.Context, statusId string) ([]string, error) {
+	opts := options.Find().SetProjection(bson.M{"_id": 1})
+	cursor, err := r.c.Find(ctx, bson.M{"deleted_at": nil, "status_id": statusId}, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	var results []struct {
+		ID primitive.ObjectID `bson:"_id"`
+	}
+	if err := cursor.All(ctx, &results); err != nil {
+		return nil, err
+	}
+
+	ids := make([]string, 0, len(results))
+	for _, result := range results {
+		ids = append(ids, result.ID.Hex())
+	}
+	return ids, nil
+}
+
+// ReplaceStatus moves the given content to another status and returns the IDs
+// of the moved ones. The content whose status is no longer fromStatusId is
+// left as it is: each update matches the old status.
+func (r *repository) ReplaceStatus(ctx context.Context, ids []string, fromStatusId string, toStatusId string) ([]string, error) {
+	objectIds := make([]primitive.ObjectID, 0, len(ids))
+	for _, id := range ids {
+		objectId, err := primitive.ObjectIDFromHex(id)
+		if err != nil {
+			return nil, errors.ErrInvalidID.Wrap(err)
+		}
+		objectIds = append(objectIds, objectId)
+	}
+
+	var moved []string
+	for _, objectId := range objectIds {
+		result, err := r.c.UpdateOne(ctx,
+			bson.M{"_id": objectId, "deleted_at": nil, "status_id": fromStatusId},
+			bson.M{"$set": bson.M{"status_id": toStatusId, "updated_at": time.Now()}},
+		)
+		if err != nil {
+			return moved, err
+		}
+		if result.MatchedCount == 1 {
+			moved = append(moved, objectId.Hex())
+		}
+	}
+	return moved, nil
+}
@@ -0,0 +1,38 @@
+package repository
+
+import (
+	"context"
+	"github.com/SpawNKZ/content_service/common/errors"
+	"github.com/SpawNKZ/content_service/content/models"
+	"go.mongodb.org/mongo-driver/bson"
+	"reflect"
+	"testing"
+)
+
+func TestContentFilterQuery(t *testing.T) {
+	got := contentFilterQuery(models.ContentFilter{
+		Locale:        "en",
+		Status:        "64b000000000000000000001",
+		ContributorId: "alice",
+	})
+
+	want := bson.M{
+		"deleted_at":           nil,
+		"locale":               "en",
+		"status_id":            "64b000000000000000000001",
+		"contributors.user_id": "alice",
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("query = %v, want %v", got, want)
+	}
+}
+
+func TestFindByIDRejectsMalformedIds(t *testing.T) {
+	r := &repository{}
+	for _, id := range []string{"abc", "zzzzzzzzzzzzzzzzzzzzzzzz"} {
+		_, err := r.FindByID(context.Background(), id)
+		if !errors.Is(err, errors.ErrInvalidID) {
+			t.Errorf("FindByID(%q) = %v, want %s", id, err, errors.ErrInvalidID.Code)
+		}
+	}
+}
@@ -4,21 +4,41 @@ import (
 	"context"
 	"encoding/json"
 	"github.com/SpawNKZ/content_service/common/errors"
+	"github.com/SpawNKZ/content_service/common/tracing"
 	"github.com/SpawNKZ/content_service/content/models"
+	"github.com/SpawNKZ/content_service/mb"
+	"github.com/go-kit/kit/endpoint"
 	natsTransport "github.com/go-kit/kit/transport/nats"
+	"github.com/go-kit/log"
 	"github.com/nats-io/nats.go"
+	"go.opentelemetry.io/otel/attribute"
 )
 
 type subjectRepository struct {
-	nc *nats.Conn
+	getById endpoint.Endpoint
 }
 
 type SubjectRepository interface {
 	GetSubjectId(ctx context.Context, subjectId int64) (*models.Subject, error)
 }
 
-func NewSubjectRepository(nc *nats.Conn) SubjectRepository {
-	return &subjectRepository{nc: nc}
+func NewSubjectRepository(nc *nats.Conn, policy mb.RequestPolicy, logger log.Logger) SubjectRepository {
+	publisher := natsTransport.NewPublisher(
+		nc,
+		"subjects.GetById",
+		natsTransport.EncodeJSONRequest,
+		decodeSubjectById,
+		natsTransport.PublisherBefore(tracing.NATSToHeader),
+		natsTransport.PublisherTimeout(policy.Timeout),
+	)
+
+	getById := mb.NewResilientMiddleware("subjects.GetById", policy, logger)(publisher.Endpoint())
+	getById = tracing.ClientMiddleware("nats subjects.GetById",
+		attribute.String("messaging.system", "nats"),
+		attribute.String("messaging.destination.name", "subjects.GetById"),
+	)(getById)
+
+	return &subjectRepository{getById: getById}
 }
 
 type getSubjectByIdRequest struct {
@@ -30,15 +50,11 @@ type getSubjectByIdResponse struct {
 }
 
 func (s *subjectRepository) GetSubjectId(ctx context.Context, subjectId int64) (*models.Subject, error) {
-	publisher := natsTransport.NewPublisher(
-		s.nc,
-		"subjects.GetById",
-		natsTransport.EncodeJSONRequest,
-		decodeSubjectById,
-	)
-
-	res, err := publisher.Endpoint()(ctx, getSubjectByIdRequest{ID: subjectId})
-	if err != nil {
+	res, err := s.getById(ctx, getSubjectByIdRequest{ID: subjectId})
+	switch {
+	case mb.Unavailable(err):
+		return nil, errors.ErrSubjectUnavailable
+	case err != nil:
 		return nil, err
 	}
 
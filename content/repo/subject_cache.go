@@ -0,0 +1,52 @@
+package repository
+
+import (
+	"github.com/SpawNKZ/content_service/content/models"
+	"sync"
+	"time"
+)
+
+// SubjectCache keeps the last subjects received from the subject service so
+// that content can still be served with them while the service is down.
+type SubjectCache interface {
+	Get(subjectId int64) (*models.Subject, bool)
+	Set(subjectId int64, subject *models.Subject)
+}
+
+type subjectCache struct {
+	mtx     sync.RWMutex
+	ttl     time.Duration
+	entries map[int64]subjectCacheEntry
+}
+
+type subjectCacheEntry struct {
+	subject  *models.Subject
+	storedAt time.Time
+}
+
+// NewSubjectCache returns an in-memory cache whose entries are no longer
+// served once they are older than ttl.
+func NewSubjectCache(ttl time.Duration) SubjectCache {
+	return &subjectCache{
+		ttl:     ttl,
+		entries: map[int64]subjectCacheEntry{},
+	}
+}
+
+func (c *subjectCache) Get(subjectId int64) (*models.Subject, bool) {
+	c.mtx.RLock()
+	defer c.mtx.RUnlock()
+
+	entry, ok := c.entries[subjectId]
+	if !ok || time.Since(entry.storedAt) > c.ttl {
+		return nil, false
+	}
+	return entry.subject, true
+}
+
+func (c *subjectCache) Set(subjectId int64, subject *models.Subject) {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	c.entries[subjectId] = subjectCacheEntry{subject: subject, storedAt: time.Now()}
+}
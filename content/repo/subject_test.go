@@ -0,0 +1,33 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/SpawNKZ/content_service/common/errors"
+	"github.com/nats-io/nats.go"
+	"testing"
+)
+
+func TestGetSubjectIdErrors(t *testing.T) {
+	malformed := &json.SyntaxError{}
+	for name, tt := range map[string]struct {
+		err  error
+		want error
+	}{
+		"timeout":   {err: nats.ErrTimeout, want: errors.ErrSubjectUnavailable},
+		"no reply":  {err: nats.ErrNoResponders, want: errors.ErrSubjectUnavailable},
+		"canceled":  {err: context.Canceled, want: context.Canceled},
+		"malformed": {err: malformed, want: malformed},
+	} {
+		t.Run(name, func(t *testing.T) {
+			repo := &subjectRepository{getById: func(context.Context, interface{}) (interface{}, error) {
+				return nil, tt.err
+			}}
+
+			_, err := repo.GetSubjectId(context.Background(), 1)
+			if err != tt.want {
+				t.Fatalf("err = %v, want %v", err, tt.want)
+			}
+		})
+	}
+}
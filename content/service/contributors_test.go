@@ -0,0 +1,84 @@
+package service
+
+import (
+	"github.com/SpawNKZ/content_service/common/auth"
+	"github.com/SpawNKZ/content_service/common/errors"
+	"github.com/SpawNKZ/content_service/content/models"
+	"reflect"
+	"testing"
+)
+
+func TestAssignAuthorKeepsCoAuthors(t *testing.T) {
+	f := newServiceFixture()
+	f.repo.content[draftContentId.Hex()].Contributors = []models.ContributorModel{
+		{UserId: "alice", Role: models.RoleAuthor},
+		{UserId: "carol", Role: models.RoleAuthor},
+		{UserId: "tom", Role: models.RoleTranslator},
+	}
+
+	err := f.service.AssignAuthor(withIdentity("root", auth.GroupAdmin), models.AssignAuthorRequest{ID: draftContentId.Hex(), AuthorId: "bob"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := []models.ContributorModel{
+		{UserId: "carol", Role: models.RoleAuthor},
+		{UserId: "tom", Role: models.RoleTranslator},
+		{UserId: "bob", Role: models.RoleAuthor},
+	}
+	if got := f.repo.content[draftContentId.Hex()].Contributors; !reflect.DeepEqual(got, want) {
+		t.Fatalf("contributors = %+v, want %+v", got, want)
+	}
+	if len(f.history.entries) != 1 {
+		t.Fatalf("history = %+v, want one entry", f.history.entries)
+	}
+	entry := f.history.entries[0]
+	if entry.Action != actionAssignAuthor || entry.UserId != "root" || entry.PreviousValue != "alice" || entry.NewValue != "bob" {
+		t.Fatalf("history = %+v, want root replacing alice with bob", entry)
+	}
+}
+
+func TestAddContributor(t *testing.T) {
+	f := newServiceFixture()
+
+	err := f.service.AddContributor(withIdentity("alice", auth.GroupAuthor), models.ContributorRequest{ID: draftContentId.Hex(), UserId: "tom", Role: models.RoleTranslator})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := []models.ContributorModel{{UserId: "tom", Role: models.RoleTranslator}}
+	if got := f.repo.content[draftContentId.Hex()].Contributors; !reflect.DeepEqual(got, want) {
+		t.Fatalf("contributors = %+v, want %+v", got, want)
+	}
+	if len(f.history.entries) != 1 || f.history.entries[0].Action != actionAddContributor || f.history.entries[0].UserId != "alice" || f.history.entries[0].NewValue != "tom:translator" {
+		t.Fatalf("history = %+v, want alice adding tom:translator", f.history.entries)
+	}
+
+	err = f.service.AddContributor(withIdentity("alice", auth.GroupAuthor), models.ContributorRequest{ID: draftContentId.Hex(), UserId: "tom", Role: "editor"})
+	assertAppError(t, err, errors.ErrInvalidRole)
+}
+
+func TestRemoveContributor(t *testing.T) {
+	f := newServiceFixture()
+	f.repo.content[draftContentId.Hex()].Contributors = []models.ContributorModel{
+		{UserId: "alice", Role: models.RoleAuthor},
+		{UserId: "tom", Role: models.RoleTranslator},
+		{UserId: "tom", Role: models.RoleIllustrator},
+	}
+
+	err := f.service.RemoveContributor(withIdentity("alice", auth.GroupAuthor), models.ContributorRequest{ID: draftContentId.Hex(), UserId: "tom", Role: models.RoleTranslator})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := []models.ContributorModel{
+		{UserId: "alice", Role: models.RoleAuthor},
+		{UserId: "tom", Role: models.RoleIllustrator},
+	}
+	if got := f.repo.content[draftContentId.Hex()].Contributors; !reflect.DeepEqual(got, want) {
+		t.Fatalf("contributors = %+v, want %+v", got, want)
+	}
+	if len(f.history.entries) != 1 || f.history.entries[0].Action != actionRemoveContributor || f.history.entries[0].PreviousValue != "tom:translator" {
+		t.Fatalf("history = %+v, want the removal of tom:translator", f.history.entries)
+	}
+}
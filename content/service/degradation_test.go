@@ -0,0 +1,90 @@
+package service
+
+import (
+	"context"
+	"github.com/SpawNKZ/content_service/common/errors"
+	"github.com/SpawNKZ/content_service/content/models"
+	repository "github.com/SpawNKZ/content_service/content/repo"
+	"reflect"
+	"testing"
+	"time"
+)
+
+const subjectId = 7
+
+func TestLoadSubjectStrict(t *testing.T) {
+	subjects := &switchableSubjectRepository{err: errors.ErrSubjectUnavailable}
+	s := newDegradationService(subjects, DegradationStrict)
+
+	_, err := s.GetOne(context.Background(), models.IdRequest{ID: draftContentId.Hex()})
+	assertAppError(t, err, errors.ErrSubjectUnavailable)
+}
+
+func TestLoadSubjectOmit(t *testing.T) {
+	subjects := &switchableSubjectRepository{err: errors.ErrSubjectUnavailable}
+	s := newDegradationService(subjects, DegradationOmit)
+
+	contentObj, err := s.GetOne(context.Background(), models.IdRequest{ID: draftContentId.Hex()})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if contentObj.Subject != nil || !reflect.DeepEqual(contentObj.Warnings, []string{models.WarningSubjectOmitted}) {
+		t.Fatalf("subject = %+v, warnings = %v, want it omitted", contentObj.Subject, contentObj.Warnings)
+	}
+
+	// Other failures are not the subject service being down.
+	subjects.err = errors.ErrNotFound
+	_, err = s.GetOne(context.Background(), models.IdRequest{ID: draftContentId.Hex()})
+	assertAppError(t, err, errors.ErrNotFound)
+}
+
+func TestLoadSubjectCache(t *testing.T) {
+	subjects := &switchableSubjectRepository{err: errors.ErrSubjectUnavailable}
+	s := newDegradationService(subjects, DegradationCache)
+	request := models.IdRequest{ID: draftContentId.Hex()}
+
+	contentObj, err := s.GetOne(context.Background(), request)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if contentObj.Subject != nil || !reflect.DeepEqual(contentObj.Warnings, []string{models.WarningSubjectOmitted}) {
+		t.Fatalf("subject = %+v, warnings = %v, want it omitted before anything is cached", contentObj.Subject, contentObj.Warnings)
+	}
+
+	subjects.err = nil
+	contentObj, err = s.GetOne(context.Background(), request)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if contentObj.Subject == nil || contentObj.Subject.ID != subjectId || len(contentObj.Warnings) != 0 {
+		t.Fatalf("subject = %+v, warnings = %v, want the fresh subject", contentObj.Subject, contentObj.Warnings)
+	}
+
+	subjects.err = errors.ErrSubjectUnavailable
+	contentObj, err = s.GetOne(context.Background(), request)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if contentObj.Subject == nil || contentObj.Subject.ID != subjectId || !reflect.DeepEqual(contentObj.Warnings, []string{models.WarningSubjectStale}) {
+		t.Fatalf("subject = %+v, warnings = %v, want the cached subject marked stale", contentObj.Subject, contentObj.Warnings)
+	}
+}
+
+func newDegradationService(subjects repository.SubjectRepository, degradation string) Service {
+	f := newServiceFixture()
+	f.repo.content[draftContentId.Hex()].SubjectId = subjectId
+	status := newFakeStatusService()
+	return New(f.repo, subjects, nil, f.history, status, repository.NewSubjectCache(time.Minute), degradation)
+}
+
+// switchableSubjectRepository fails with err while it is set.
+type switchableSubjectRepository struct {
+	err error
+}
+
+func (r *switchableSubjectRepository) GetSubjectId(_ context.Context, subjectId int64) (*models.Subject, error) {
+	if r.err != nil {
+		return nil, r.err
+	}
+	return &models.Subject{SubjectBase: models.SubjectBase{ID: subjectId}}, nil
+}
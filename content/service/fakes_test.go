@@ -0,0 +1,284 @@
+package service
+
+import (
+	"context"
+	"github.com/SpawNKZ/content_service/common/errors"
+	"github.com/SpawNKZ/content_service/content/models"
+	contentHistoryModel "github.com/SpawNKZ/content_service/content_history/models"
+	contentStatusModel "github.com/SpawNKZ/content_service/content_status/models"
+	kitJwt "github.com/go-kit/kit/auth/jwt"
+	"github.com/golang-jwt/jwt/v4"
+)
+
+// withIdentity returns a context carrying the claims auth.NewParser puts
+// there for a valid token.
+func withIdentity(userId string, groups ...string) context.Context {
+	claimGroups := make([]interface{}, 0, len(groups))
+	for _, group := range groups {
+		claimGroups = append(claimGroups, group)
+	}
+	return context.WithValue(context.Background(), kitJwt.JWTClaimsContextKey, jwt.MapClaims{
+		"sub":    userId,
+		"groups": claimGroups,
+	})
+}
+
+// fakeStatusService serves the statuses it holds, keyed by ID.
+type fakeStatusService struct {
+	statuses map[string]*contentStatusModel.ContentStatus
+}
+
+func newFakeStatusService(statuses ...*contentStatusModel.ContentStatus) *fakeStatusService {
+	s := &fakeStatusService{statuses: map[string]*contentStatusModel.ContentStatus{}}
+	for _, status := range statuses {
+		s.statuses[status.ID] = status
+	}
+	return s
+}
+
+func (s *fakeStatusService) Create(context.Context, contentStatusModel.CreateRequest) (string, error) {
+	return "", errors.ErrInternal
+}
+
+func (s *fakeStatusService) GetOne(_ context.Context, data contentStatusModel.IdRequest) (*contentStatusModel.ContentStatus, error) {
+	status, ok := s.statuses[data.ID]
+	if !ok {
+		return nil, errors.ErrNotFound
+	}
+	copied := *status
+	return &copied, nil
+}
+
+func (s *fakeStatusService) GetByName(_ context.Context, name string) (*contentStatusModel.ContentStatus, error) {
+	for _, status := range s.statuses {
+		if status.Name == name {
+			copied := *status
+			return &copied, nil
+		}
+	}
+	return nil, errors.ErrNotFound
+}
+
+func (s *fakeStatusService) Update(context.Context, contentStatusModel.UpdateRequest) error {
+	return errors.ErrInternal
+}
+
+func (s *fakeStatusService) Delete(context.Context, contentStatusModel.DeleteRequest) error {
+	return errors.ErrInternal
+}
+
+func (s *fakeStatusService) GetList(context.Context, contentStatusModel.GetListRequest) ([]*contentStatusModel.ContentStatus, contentStatusModel.Pagination, error) {
+	return nil, contentStatusModel.Pagination{}, errors.ErrInternal
+}
+
+// fakeService records the calls that passed the policy.
+type fakeService struct {
+	content map[string]*models.Content
+	calls   []string
+}
+
+func (s *fakeService) Create(_ context.Context, data models.CreateRequest) (string, error) {
+	s.calls = append(s.calls, "Create:"+data.AuthorId)
+	return "created", nil
+}
+
+func (s *fakeService) GetOne(_ context.Context, data models.IdRequest) (*models.Content, error) {
+	contentObj, ok := s.content[data.ID]
+	if !ok {
+		return nil, errors.ErrNotFound
+	}
+	return contentObj, nil
+}
+
+func (s *fakeService) Update(context.Context, models.UpdateRequest) error {
+	s.calls = append(s.calls, "Update")
+	return nil
+}
+
+func (s *fakeService) AssignAuthor(context.Context, models.AssignAuthorRequest) error {
+	s.calls = append(s.calls, "AssignAuthor")
+	return nil
+}
+
+func (s *fakeService) AddContributor(context.Context, models.ContributorRequest) error {
+	s.calls = append(s.calls, "AddContributor")
+	return nil
+}
+
+func (s *fakeService) RemoveContributor(context.Context, models.ContributorRequest) error {
+	s.calls = append(s.calls, "RemoveContributor")
+	return nil
+}
+
+func (s *fakeService) ChangeStatus(context.Context, models.ChangeStatusRequest) error {
+	s.calls = append(s.calls, "ChangeStatus")
+	return nil
+}
+
+func (s *fakeService) Delete(context.Context, models.IdRequest) error {
+	s.calls = append(s.calls, "Delete")
+	return nil
+}
+
+func (s *fakeService) GetList(context.Context, models.GetListRequest) ([]*models.Content, models.Pagination, error) {
+	return nil, models.Pagination{}, nil
+}
+
+// fakeRepository holds the content keyed by ID and remembers the filters
+// it was asked for.
+type fakeRepository struct {
+	content map[string]*models.ContentModel
+	filters []models.ContentFilter
+}
+
+func newFakeRepository(content ...*models.ContentModel) *fakeRepository {
+	r := &fakeRepository{content: map[string]*models.ContentModel{}}
+	for _, contentObj := range content {
+		r.content[contentObj.ID.Hex()] = contentObj
+	}
+	return r
+}
+
+func (r *fakeRepository) Insert(context.Context, models.Content) (string, error) {
+	return "", errors.ErrInternal
+}
+
+func (r *fakeRepository) FindByID(_ context.Context, id string) (*models.ContentModel, error) {
+	contentObj, ok := r.content[id]
+	if !ok {
+		return nil, errors.ErrNotFound
+	}
+	copied := *contentObj
+	return &copied, nil
+}
+
+func (r *fakeRepository) UpdateStatus(_ context.Context, updateObj models.ChangeStatusRequest) error {
+	contentObj, ok := r.content[updateObj.ID]
+	if !ok {
+		return errors.ErrNotFound
+	}
+	contentObj.StatusId = updateObj.StatusId
+	return nil
+}
+
+func (r *fakeRepository) Update(context.Context, models.UpdateRequest) error {
+	return errors.ErrInternal
+}
+
+// UpdateAuthor replaces the author entry of the outgoing author only, as
+// the repository does.
+func (r *fakeRepository) UpdateAuthor(_ context.Context, updateObj models.AssignAuthorRequest) error {
+	contentObj, ok := r.content[updateObj.ID]
+	if !ok {
+		return errors.ErrNotFound
+	}
+	contributors := []models.ContributorModel{}
+	for _, contributor := range contentObj.Contributors {
+		outgoing := contributor.UserId == contentObj.AuthorId || contributor.UserId == updateObj.AuthorId
+		if contributor.Role != models.RoleAuthor || !outgoing {
+			contributors = append(contributors, contributor)
+		}
+	}
+	contentObj.AuthorId = updateObj.AuthorId
+	contentObj.Contributors = append(contributors, models.ContributorModel{UserId: updateObj.AuthorId, Role: models.RoleAuthor})
+	return nil
+}
+
+func (r *fakeRepository) AddContributor(_ context.Context, contributorObj models.ContributorRequest) error {
+	contentObj, ok := r.content[contributorObj.ID]
+	if !ok {
+		return errors.ErrNotFound
+	}
+	contributor := models.ContributorModel{UserId: contributorObj.UserId, Role: contributorObj.Role}
+	for _, existing := range contentObj.Contributors {
+		if existing == contributor {
+			return nil
+		}
+	}
+	contentObj.Contributors = append(contentObj.Contributors, contributor)
+	return nil
+}
+
+func (r *fakeRepository) RemoveContributor(_ context.Context, contributorObj models.ContributorRequest) error {
+	contentObj, ok := r.content[contributorObj.ID]
+	if !ok {
+		return errors.ErrNotFound
+	}
+	contributors := []models.ContributorModel{}
+	for _, contributor := range contentObj.Contributors {
+		removed := contributor.UserId == contributorObj.UserId && (contributorObj.Role == "" || contributor.Role == contributorObj.Role)
+		if !removed {
+			contributors = append(contributors, contributor)
+		}
+	}
+	contentObj.Contributors = contributors
+	return nil
+}
+
+func (r *fakeRepository) DeleteByID(_ context.Context, id string) error {
+	if _, ok := r.content[id]; !ok {
+		return errors.ErrNotFound
+	}
+	delete(r.content, id)
+	return nil
+}
+
+func (r *fakeRepository) Count(_ context.Context, filter models.ContentFilter) (int64, error) {
+	r.filters = append(r.filters, filter)
+	return int64(len(r.content)), nil
+}
+
+func (r *fakeRepository) GetAll(_ context.Context, _ int64, _ int64, filter models.ContentFilter) ([]*models.Content, error) {
+	r.filters = append(r.filters, filter)
+	return nil, nil
+}
+
+func (r *fakeRepository) CountByStatus(_ context.Context, statusId string) (int64, error) {
+	var count int64
+	for _, contentObj := range r.content {
+		if contentObj.StatusId == statusId {
+			count++
+		}
+	}
+	return count, nil
+}
+
+func (r *fakeRepository) FindIdsByStatus(_ context.Context, statusId string) ([]string, error) {
+	var ids []string
+	for id, contentObj := range r.content {
+		if contentObj.StatusId == statusId {
+			ids = append(ids, id)
+		}
+	}
+	return ids, nil
+}
+
+func (r *fakeRepository) ReplaceStatus(_ context.Context, ids []string, fromStatusId string, toStatusId string) ([]string, error) {
+	var moved []string
+	for _, id := range ids {
+		if contentObj, ok := r.content[id]; ok && contentObj.StatusId == fromStatusId {
+			contentObj.StatusId = toStatusId
+			moved = append(moved, id)
+		}
+	}
+	return moved, nil
+}
+
+type fakeSubjectRepository struct{}
+
+func (fakeSubjectRepository) GetSubjectId(_ context.Context, subjectId int64) (*models.Subject, error) {
+	return &models.Subject{}, nil
+}
+
+type fakeHistoryService struct {
+	entries []contentHistoryModel.ContentHistory
+}
+
+func (s *fakeHistoryService) Create(_ context.Context, data contentHistoryModel.ContentHistory) error {
+	s.entries = append(s.entries, data)
+	return nil
+}
+
+func (s *fakeHistoryService) GetList(context.Context, contentHistoryModel.GetListRequest) ([]*contentHistoryModel.ContentHistory, contentHistoryModel.Pagination, error) {
+	return nil, contentHistoryModel.Pagination{}, nil
+}
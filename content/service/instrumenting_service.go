@@ -0,0 +1,92 @@
+package service
+
+import (
+	"context"
+	"github.com/SpawNKZ/content_service/common/instrumenting"
+	"github.com/SpawNKZ/content_service/content/models"
+	"time"
+)
+
+type instrumentingService struct {
+	metrics instrumenting.RequestMetrics
+	service Service
+}
+
+func NewInstrumentingService(metrics instrumenting.RequestMetrics, service Service) Service {
+	return &instrumentingService{
+		metrics: metrics,
+		service: service,
+	}
+}
+
+func (s *instrumentingService) Create(ctx context.Context, request models.CreateRequest) (result string, err error) {
+	defer func(begin time.Time) {
+		s.metrics.Observe("Create", begin, err)
+	}(time.Now())
+
+	return s.service.Create(ctx, request)
+}
+
+func (s *instrumentingService) GetOne(ctx context.Context, request models.IdRequest) (result *models.Content, err error) {
+	defer func(begin time.Time) {
+		s.metrics.Observe("GetOne", begin, err)
+	}(time.Now())
+
+	return s.service.GetOne(ctx, request)
+}
+
+func (s *instrumentingService) GetList(ctx context.Context, request models.GetListRequest) (result []*models.Content, pagination models.Pagination, err error) {
+	defer func(begin time.Time) {
+		s.metrics.Observe("GetList", begin, err)
+	}(time.Now())
+
+	return s.service.GetList(ctx, request)
+}
+
+func (s *instrumentingService) Update(ctx context.Context, request models.UpdateRequest) (err error) {
+	defer func(begin time.Time) {
+		s.metrics.Observe("Update", begin, err)
+	}(time.Now())
+
+	return s.service.Update(ctx, request)
+}
+
+func (s *instrumentingService) AssignAuthor(ctx context.Context, request models.AssignAuthorRequest) (err error) {
+	defer func(begin time.Time) {
+		s.metrics.Observe("AssignAuthor", begin, err)
+	}(time.Now())
+
+	return s.service.AssignAuthor(ctx, request)
+}
+
+func (s *instrumentingService) AddContributor(ctx context.Context, request models.ContributorRequest) (err error) {
+	defer func(begin time.Time) {
+		s.metrics.Observe("AddContributor", begin, err)
+	}(time.Now())
+
+	return s.service.AddContributor(ctx, request)
+}
+
+func (s *instrumentingService) RemoveContributor(ctx context.Context, request models.ContributorRequest) (err error) {
+	defer func(begin time.Time) {
+		s.metrics.Observe("RemoveContributor", begin, err)
+	}(time.Now())
+
+	return s.service.RemoveContributor(ctx, request)
+}
+
+func (s *instrumentingService) Delete(ctx context.Context, request models.IdRequest) (err error) {
+	defer func(begin time.Time) {
+		s.metrics.Observe("Delete", begin, err)
+	}(time.Now())
+
+	return s.service.Delete(ctx, request)
+}
+
+func (s *instrumentingService) ChangeStatus(ctx context.Context, request models.ChangeStatusRequest) (err error) {
+	defer func(begin time.Time) {
+		s.metrics.Observe("ChangeStatus", begin, err)
+	}(time.Now())
+
+	return s.service.ChangeStatus(ctx, request)
+}
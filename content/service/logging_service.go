@@ -79,6 +79,34 @@ func (l *loggingService) AssignAuthor(ctx context.Context, request models.Assign
 	return l.service.AssignAuthor(ctx, request)
 }
 
+func (l *loggingService) AddContributor(ctx context.Context, request models.ContributorRequest) (err error) {
+	defer func(begin time.Time) {
+		l.logger.Log(
+			"method", "AddContributor",
+			"id", request.ID,
+			"user_id", request.UserId,
+			"role", request.Role,
+			"err", err,
+		)
+	}(time.Now())
+
+	return l.service.AddContributor(ctx, request)
+}
+
+func (l *loggingService) RemoveContributor(ctx context.Context, request models.ContributorRequest) (err error) {
+	defer func(begin time.Time) {
+		l.logger.Log(
+			"method", "RemoveContributor",
+			"id", request.ID,
+			"user_id", request.UserId,
+			"role", request.Role,
+			"err", err,
+		)
+	}(time.Now())
+
+	return l.service.RemoveContributor(ctx, request)
+}
+
 func (l *loggingService) Delete(ctx context.Context, request models.IdRequest) (err error) {
 	defer func(begin time.Time) {
 		l.logger.Log(
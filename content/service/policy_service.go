@@ -0,0 +1,153 @@
+package service
+
+import (
+	"context"
+	"github.com/SpawNKZ/content_service/common/auth"
+	"github.com/SpawNKZ/content_service/common/errors"
+	"github.com/SpawNKZ/content_service/content/models"
+	contentStatusModel "github.com/SpawNKZ/content_service/content_status/models"
+	contentStatus "github.com/SpawNKZ/content_service/content_status/service"
+)
+
+// policyService enforces who may modify content:
+// authors edit only their own drafts, reviewers change status,
+// admins are allowed everything including reassigning authors and deleting.
+type policyService struct {
+	service Service
+	status  contentStatus.Service
+}
+
+func NewPolicyService(service Service, status contentStatus.Service) Service {
+	return &policyService{
+		service: service,
+		status:  status,
+	}
+}
+
+func (p *policyService) Create(ctx context.Context, data models.CreateRequest) (string, error) {
+	identity, err := p.authorize(ctx, auth.GroupAdmin, auth.GroupAuthor)
+	if err != nil {
+		return "", err
+	}
+
+	if !identity.IsAdmin() || data.AuthorId == "" {
+		data.AuthorId = identity.UserId
+	}
+
+	return p.service.Create(ctx, data)
+}
+
+func (p *policyService) GetOne(ctx context.Context, data models.IdRequest) (*models.Content, error) {
+	return p.service.GetOne(ctx, data)
+}
+
+func (p *policyService) GetList(ctx context.Context, data models.GetListRequest) ([]*models.Content, models.Pagination, error) {
+	return p.service.GetList(ctx, data)
+}
+
+func (p *policyService) Update(ctx context.Context, data models.UpdateRequest) error {
+	identity, err := p.authorize(ctx, auth.GroupAdmin, auth.GroupAuthor)
+	if err != nil {
+		return err
+	}
+
+	if !identity.IsAdmin() {
+		contentObj, err := p.service.GetOne(ctx, models.IdRequest{ID: data.ID})
+		if err != nil {
+			return err
+		}
+
+		if contentObj.AuthorId != identity.UserId {
+			return errors.ErrForbidden
+		}
+
+		isDraft, err := p.isDraft(ctx, contentObj)
+		if err != nil {
+			return err
+		}
+		if !isDraft {
+			return errors.ErrForbidden
+		}
+	}
+
+	return p.service.Update(ctx, data)
+}
+
+func (p *policyService) AssignAuthor(ctx context.Context, data models.AssignAuthorRequest) error {
+	if _, err := p.authorize(ctx, auth.GroupAdmin); err != nil {
+		return err
+	}
+	return p.service.AssignAuthor(ctx, data)
+}
+
+func (p *policyService) AddContributor(ctx context.Context, data models.ContributorRequest) error {
+	if err := p.authorizeOwner(ctx, data.ID); err != nil {
+		return err
+	}
+	return p.service.AddContributor(ctx, data)
+}
+
+func (p *policyService) RemoveContributor(ctx context.Context, data models.ContributorRequest) error {
+	if err := p.authorizeOwner(ctx, data.ID); err != nil {
+		return err
+	}
+	return p.service.RemoveContributor(ctx, data)
+}
+
+func (p *policyService) ChangeStatus(ctx context.Context, data models.ChangeStatusRequest) error {
+	if _, err := p.authorize(ctx, auth.GroupAdmin, auth.GroupReviewer); err != nil {
+		return err
+	}
+	return p.service.ChangeStatus(ctx, data)
+}
+
+func (p *policyService) Delete(ctx context.Context, data models.IdRequest) error {
+	if _, err := p.authorize(ctx, auth.GroupAdmin); err != nil {
+		return err
+	}
+	return p.service.Delete(ctx, data)
+}
+
+func (p *policyService) authorize(ctx context.Context, groups ...string) (*auth.Identity, error) {
+	identity, ok := auth.FromContext(ctx)
+	if !ok {
+		return nil, errors.ErrUnauthorized
+	}
+
+	if !identity.HasGroup(groups...) {
+		return nil, errors.ErrForbidden
+	}
+
+	return identity, nil
+}
+
+// authorizeOwner allows admins and the author of the given content.
+func (p *policyService) authorizeOwner(ctx context.Context, id string) error {
+	identity, err := p.authorize(ctx, auth.GroupAdmin, auth.GroupAuthor)
+	if err != nil {
+		return err
+	}
+
+	if identity.IsAdmin() {
+		return nil
+	}
+
+	contentObj, err := p.service.GetOne(ctx, models.IdRequest{ID: id})
+	if err != nil {
+		return err
+	}
+
+	if contentObj.AuthorId != identity.UserId {
+		return errors.ErrForbidden
+	}
+	return nil
+}
+
+func (p *policyService) isDraft(ctx context.Context, contentObj *models.Content) (bool, error) {
+	draft, err := p.status.GetByName(ctx, contentStatusModel.StatusDraft)
+	if err != nil {
+		return false, err
+	}
+
+	return contentObj.StatusId == draft.ID, nil
+}
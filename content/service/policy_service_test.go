@@ -0,0 +1,131 @@
+package service
+
+import (
+	"context"
+	"github.com/SpawNKZ/content_service/common/auth"
+	"github.com/SpawNKZ/content_service/common/errors"
+	"github.com/SpawNKZ/content_service/content/models"
+	contentStatusModel "github.com/SpawNKZ/content_service/content_status/models"
+	"testing"
+)
+
+const (
+	draftId     = "000000000000000000000001"
+	publishedId = "000000000000000000000002"
+)
+
+func newPolicyFixture() (*fakeService, Service) {
+	inner := &fakeService{content: map[string]*models.Content{
+		"draft-of-alice":     {ID: "draft-of-alice", AuthorId: "alice", StatusId: draftId},
+		"published-of-alice": {ID: "published-of-alice", AuthorId: "alice", StatusId: publishedId},
+	}}
+	status := newFakeStatusService(
+		&contentStatusModel.ContentStatus{ID: draftId, Name: contentStatusModel.StatusDraft, IsRemovable: true},
+		&contentStatusModel.ContentStatus{ID: publishedId, Name: contentStatusModel.StatusPublished},
+	)
+	return inner, NewPolicyService(inner, status)
+}
+
+func TestPolicyUpdate(t *testing.T) {
+	tests := []struct {
+		name string
+		ctx  context.Context
+		id   string
+		want *errors.AppError
+	}{
+		{"anonymous", context.Background(), "draft-of-alice", errors.ErrUnauthorized},
+		{"reviewer", withIdentity("rita", auth.GroupReviewer), "draft-of-alice", errors.ErrForbidden},
+		{"author of the draft", withIdentity("alice", auth.GroupAuthor), "draft-of-alice", nil},
+		{"other author", withIdentity("bob", auth.GroupAuthor), "draft-of-alice", errors.ErrForbidden},
+		{"author of published content", withIdentity("alice", auth.GroupAuthor), "published-of-alice", errors.ErrForbidden},
+		{"admin on published content", withIdentity("root", auth.GroupAdmin), "published-of-alice", nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			inner, policy := newPolicyFixture()
+			err := policy.Update(tt.ctx, models.UpdateRequest{ID: tt.id})
+			assertAppError(t, err, tt.want)
+			if called := len(inner.calls) == 1; called != (tt.want == nil) {
+				t.Fatalf("Update reached the service: %v, want %v", called, tt.want == nil)
+			}
+		})
+	}
+}
+
+func TestPolicyCreateSetsAuthor(t *testing.T) {
+	tests := []struct {
+		name     string
+		ctx      context.Context
+		authorId string
+		want     string
+	}{
+		{"author cannot create for someone else", withIdentity("alice", auth.GroupAuthor), "bob", "Create:alice"},
+		{"admin creates for someone else", withIdentity("root", auth.GroupAdmin), "bob", "Create:bob"},
+		{"admin without author creates for themselves", withIdentity("root", auth.GroupAdmin), "", "Create:root"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			inner, policy := newPolicyFixture()
+			if _, err := policy.Create(tt.ctx, models.CreateRequest{AuthorId: tt.authorId}); err != nil {
+				t.Fatal(err)
+			}
+			if len(inner.calls) != 1 || inner.calls[0] != tt.want {
+				t.Fatalf("calls = %v, want [%s]", inner.calls, tt.want)
+			}
+		})
+	}
+}
+
+func TestPolicyGroups(t *testing.T) {
+	tests := []struct {
+		name string
+		ctx  context.Context
+		call func(context.Context, Service) error
+		want *errors.AppError
+	}{
+		{"author cannot change status", withIdentity("alice", auth.GroupAuthor), changeStatus, errors.ErrForbidden},
+		{"reviewer changes status", withIdentity("rita", auth.GroupReviewer), changeStatus, nil},
+		{"reviewer cannot delete", withIdentity("rita", auth.GroupReviewer), deleteContent, errors.ErrForbidden},
+		{"admin deletes", withIdentity("root", auth.GroupAdmin), deleteContent, nil},
+		{"author cannot assign authors", withIdentity("alice", auth.GroupAuthor), assignAuthor, errors.ErrForbidden},
+		{"owner adds contributors", withIdentity("alice", auth.GroupAuthor), addContributor, nil},
+		{"other author cannot add contributors", withIdentity("bob", auth.GroupAuthor), addContributor, errors.ErrForbidden},
+		{"anonymous cannot add contributors", context.Background(), addContributor, errors.ErrUnauthorized},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, policy := newPolicyFixture()
+			assertAppError(t, tt.call(tt.ctx, policy), tt.want)
+		})
+	}
+}
+
+func changeStatus(ctx context.Context, s Service) error {
+	return s.ChangeStatus(ctx, models.ChangeStatusRequest{ID: "draft-of-alice", StatusId: publishedId})
+}
+
+func deleteContent(ctx context.Context, s Service) error {
+	return s.Delete(ctx, models.IdRequest{ID: "draft-of-alice"})
+}
+
+func assignAuthor(ctx context.Context, s Service) error {
+	return s.AssignAuthor(ctx, models.AssignAuthorRequest{ID: "draft-of-alice", AuthorId: "bob"})
+}
+
+func addContributor(ctx context.Context, s Service) error {
+	return s.AddContributor(ctx, models.ContributorRequest{ID: "draft-of-alice", UserId: "bob", Role: "editor"})
+}
+
+// assertAppError fails unless err is want, or nil when want is nil.
+func assertAppError(t *testing.T, err error, want *errors.AppError) {
+	t.Helper()
+	if want == nil {
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		return
+	}
+	if !errors.Is(err, want) {
+		t.Fatalf("err = %v, want %s", err, want.Code)
+	}
+}
@@ -2,7 +2,9 @@ package service
 
 import (
 	"context"
+	"github.com/SpawNKZ/content_service/common/auth"
 	"github.com/SpawNKZ/content_service/common/errors"
+	"github.com/SpawNKZ/content_service/common/pagination"
 	"github.com/SpawNKZ/content_service/content/models"
 	repository "github.com/SpawNKZ/content_service/content/repo"
 	contentHistoryModel "github.com/SpawNKZ/content_service/content_history/models"
@@ -13,17 +15,24 @@ import (
 )
 
 const (
-	actionCreate       = "create"
-	actionUpdate       = "update"
-	actionAssignAuthor = "assign_author"
-	actionDelete       = "delete"
-	actionChangeStatus = "change_status"
+	actionCreate            = "create"
+	actionUpdate            = "update"
+	actionAssignAuthor      = "assign_author"
+	actionDelete            = "delete"
+	actionChangeStatus      = "change_status"
+	actionAddContributor    = "add_contributor"
+	actionRemoveContributor = "remove_contributor"
 )
 
+// Degradation modes of content reads while the subject service is unavailable.
+// Writes always fail, since the subject cannot be validated.
 const (
-	statusDraft     = "draft"
-	statusArchived  = "archived"
-	statusPublished = "published"
+	// DegradationStrict fails the read.
+	DegradationStrict = "strict"
+	// DegradationOmit serves the content without its subject.
+	DegradationOmit = "omit"
+	// DegradationCache serves the last known subject, or none if it is not cached.
+	DegradationCache = "cache"
 )
 
 type Service interface {
@@ -31,26 +40,32 @@ type Service interface {
 	GetOne(ctx context.Context, data models.IdRequest) (*models.Content, error)
 	Update(ctx context.Context, data models.UpdateRequest) error
 	AssignAuthor(ctx context.Context, data models.AssignAuthorRequest) error
+	AddContributor(ctx context.Context, data models.ContributorRequest) error
+	RemoveContributor(ctx context.Context, data models.ContributorRequest) error
 	ChangeStatus(ctx context.Context, data models.ChangeStatusRequest) error
 	Delete(ctx context.Context, data models.IdRequest) error
 	GetList(ctx context.Context, data models.GetListRequest) ([]*models.Content, models.Pagination, error)
 }
 
 type service struct {
-	repo       repository.Repository
-	history    contentHistory.Service
-	subject    repository.SubjectRepository
-	microtopic repository.MicrotopicRepository
-	status     contentStatus.Service
+	repo        repository.Repository
+	history     contentHistory.Service
+	subject     repository.SubjectRepository
+	microtopic  repository.MicrotopicRepository
+	status      contentStatus.Service
+	subjects    repository.SubjectCache
+	degradation string
 }
 
-func New(repo repository.Repository, subject repository.SubjectRepository, microtopic repository.MicrotopicRepository, history contentHistory.Service, status contentStatus.Service) Service {
+func New(repo repository.Repository, subject repository.SubjectRepository, microtopic repository.MicrotopicRepository, history contentHistory.Service, status contentStatus.Service, subjects repository.SubjectCache, degradation string) Service {
 	return &service{
-		repo:       repo,
-		subject:    subject,
-		history:    history,
-		microtopic: microtopic,
-		status:     status,
+		repo:        repo,
+		subject:     subject,
+		history:     history,
+		microtopic:  microtopic,
+		status:      status,
+		subjects:    subjects,
+		degradation: degradation,
 	}
 }
 
@@ -65,12 +80,12 @@ func (s *service) Create(ctx context.Context, data models.CreateRequest) (string
 		return "", err
 	}
 
-	contentStatusObj, err := s.status.GetByName(ctx, statusDraft)
+	contentStatusObj, err := s.status.GetByName(ctx, contentStatusModel.StatusDraft)
 	if err != nil {
 		return "", err
 	}
 
-	data.StatusId = contentStatusObj.Name
+	data.StatusId = contentStatusObj.ID
 	contentId, err := s.repo.Insert(ctx, *data.ToContent())
 	if err != nil {
 		return "", err
@@ -99,19 +114,84 @@ func (s *service) GetOne(ctx context.Context, data models.IdRequest) (*models.Co
 		return nil, err
 	}
 
-	subject, err := s.subject.GetSubjectId(ctx, contentObj.SubjectId)
+	var contentRes models.Content
+	contentRes.FromRepositoryModel(contentObj)
+
+	err = s.loadSubject(ctx, &contentRes)
 	if err != nil {
 		return nil, err
 	}
 
-	var contentRes models.Content
-	contentRes.FromRepositoryModel(contentObj)
-	contentRes.Subject = subject
+	err = s.loadStatus(ctx, &contentRes, map[string]*models.Status{})
+	if err != nil {
+		return nil, err
+	}
 
 	return &contentRes, nil
 }
 
+// loadStatus attaches the status to the content. Statuses are looked up once
+// per request, resolved holds the ones already found.
+func (s *service) loadStatus(ctx context.Context, contentObj *models.Content, resolved map[string]*models.Status) error {
+	if status, ok := resolved[contentObj.StatusId]; ok {
+		contentObj.Status = status
+		return nil
+	}
+
+	statusObj, err := s.status.GetOne(ctx, contentStatusModel.IdRequest{ID: contentObj.StatusId})
+	switch {
+	case err == nil:
+		contentObj.Status = &models.Status{
+			ID:          statusObj.ID,
+			Name:        statusObj.Name,
+			IsRemovable: statusObj.IsRemovable,
+			IsSystem:    statusObj.IsSystem,
+		}
+	case errors.Is(err, errors.ErrNotFound), errors.Is(err, errors.ErrInvalidID):
+		// The content refers to a status that is gone, it is served without one.
+	default:
+		return err
+	}
+
+	resolved[contentObj.StatusId] = contentObj.Status
+	return nil
+}
+
+// loadSubject attaches the subject to the content. While the subject service
+// is unavailable the content is served according to the degradation mode,
+// with a warning telling the client the subject is missing or outdated.
+func (s *service) loadSubject(ctx context.Context, contentObj *models.Content) error {
+	subject, err := s.subject.GetSubjectId(ctx, contentObj.SubjectId)
+	switch {
+	case err == nil:
+		if s.degradation == DegradationCache {
+			s.subjects.Set(contentObj.SubjectId, subject)
+		}
+		contentObj.Subject = subject
+		return nil
+	case !errors.Is(err, errors.ErrSubjectUnavailable) || s.degradation == DegradationStrict:
+		return err
+	}
+
+	if s.degradation == DegradationCache {
+		if cached, ok := s.subjects.Get(contentObj.SubjectId); ok {
+			contentObj.Subject = cached
+			contentObj.Warnings = append(contentObj.Warnings, models.WarningSubjectStale)
+			return nil
+		}
+	}
+
+	contentObj.Warnings = append(contentObj.Warnings, models.WarningSubjectOmitted)
+	return nil
+}
+
 func (s *service) GetList(ctx context.Context, data models.GetListRequest) ([]*models.Content, models.Pagination, error) {
+	limit, offset, err := pagination.Normalize(data.Limit, data.Offset)
+	if err != nil {
+		return nil, models.Pagination{}, err
+	}
+	data.Limit, data.Offset = limit, offset
+
 	count, err := s.repo.Count(ctx, data.ContentFilter)
 
 	if err != nil {
@@ -123,6 +203,13 @@ func (s *service) GetList(ctx context.Context, data models.GetListRequest) ([]*m
 		return nil, models.Pagination{}, errors.ErrDB
 	}
 
+	resolved := map[string]*models.Status{}
+	for _, contentObj := range contentList {
+		if err := s.loadStatus(ctx, contentObj, resolved); err != nil {
+			return nil, models.Pagination{}, err
+		}
+	}
+
 	hasNextPage := int64(data.Offset+data.Limit) < count
 
 	return contentList, models.Pagination{
@@ -168,10 +255,43 @@ func (s *service) AssignAuthor(ctx context.Context, data models.AssignAuthorRequ
 		return err
 	}
 
+	// The outgoing author leaves the contributors, the previous value
+	// records it.
+	err = s.history.Create(ctx, contentHistoryModel.ContentHistory{
+		ContentId:     data.ID,
+		UserId:        actorId(ctx, contentObj),
+		Action:        actionAssignAuthor,
+		PreviousValue: contentObj.AuthorId,
+		NewValue:      data.AuthorId,
+		CreatedAt:     time.Now(),
+	})
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (s *service) AddContributor(ctx context.Context, data models.ContributorRequest) error {
+	if !models.IsValidRole(data.Role) {
+		return errors.ErrInvalidRole
+	}
+
+	contentObj, err := s.GetOne(ctx, models.IdRequest{ID: data.ID})
+	if err != nil {
+		return err
+	}
+
+	err = s.repo.AddContributor(ctx, data)
+	if err != nil {
+		return err
+	}
+
 	err = s.history.Create(ctx, contentHistoryModel.ContentHistory{
 		ContentId: data.ID,
-		UserId:    contentObj.AuthorId,
-		Action:    actionAssignAuthor,
+		UserId:    actorId(ctx, contentObj),
+		Action:    actionAddContributor,
+		NewValue:  data.UserId + ":" + data.Role,
 		CreatedAt: time.Now(),
 	})
 	if err != nil {
@@ -181,6 +301,35 @@ func (s *service) AssignAuthor(ctx context.Context, data models.AssignAuthorRequ
 	return nil
 }
 
+func (s *service) RemoveContributor(ctx context.Context, data models.ContributorRequest) error {
+	if data.Role != "" && !models.IsValidRole(data.Role) {
+		return errors.ErrInvalidRole
+	}
+
+	contentObj, err := s.GetOne(ctx, models.IdRequest{ID: data.ID})
+	if err != nil {
+		return err
+	}
+
+	err = s.repo.RemoveContributor(ctx, data)
+	if err != nil {
+		return err
+	}
+
+	err = s.history.Create(ctx, contentHistoryModel.ContentHistory{
+		ContentId:     data.ID,
+		UserId:        actorId(ctx, contentObj),
+		Action:        actionRemoveContributor,
+		PreviousValue: data.UserId + ":" + data.Role,
+		CreatedAt:     time.Now(),
+	})
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
 func (s *service) ChangeStatus(ctx context.Context, data models.ChangeStatusRequest) error {
 	contentObj, err := s.GetOne(ctx, models.IdRequest{ID: data.ID})
 	if err != nil {
@@ -198,10 +347,12 @@ func (s *service) ChangeStatus(ctx context.Context, data models.ChangeStatusRequ
 	}
 
 	err = s.history.Create(ctx, contentHistoryModel.ContentHistory{
-		ContentId: data.ID,
-		UserId:    contentObj.AuthorId,
-		Action:    actionChangeStatus,
-		CreatedAt: time.Now(),
+		ContentId:     data.ID,
+		UserId:        contentObj.AuthorId,
+		Action:        actionChangeStatus,
+		PreviousValue: contentObj.StatusId,
+		NewValue:      data.StatusId,
+		CreatedAt:     time.Now(),
 	})
 	if err != nil {
 		return err
@@ -216,12 +367,11 @@ func (s *service) Delete(ctx context.Context, data models.IdRequest) error {
 		return err
 	}
 
-	statusObj, err := s.status.GetOne(ctx, contentStatusModel.IdRequest{ID: data.ID})
-	if err != nil {
-		return err
+	if contentObj.Status == nil {
+		return errors.ErrNotFound.WithMessage("status of the content not found")
 	}
 
-	if statusObj.IsRemovable == false {
+	if contentObj.Status.IsRemovable == false {
 		return errors.ErrContentIsNotRemovable
 	}
 
@@ -244,3 +394,12 @@ func (s *service) Delete(ctx context.Context, data models.IdRequest) error {
 
 	return nil
 }
+
+// actorId returns the user performing the request, falling back to the content author
+// for calls made without an authenticated identity.
+func actorId(ctx context.Context, contentObj *models.Content) string {
+	if identity, ok := auth.FromContext(ctx); ok {
+		return identity.UserId
+	}
+	return contentObj.AuthorId
+}
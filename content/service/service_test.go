@@ -0,0 +1,98 @@
+package service
+
+import (
+	"github.com/SpawNKZ/content_service/common/errors"
+	"github.com/SpawNKZ/content_service/content/models"
+	contentStatusModel "github.com/SpawNKZ/content_service/content_status/models"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"testing"
+)
+
+var (
+	draftContentId     = primitive.NewObjectID()
+	publishedContentId = primitive.NewObjectID()
+)
+
+type serviceFixture struct {
+	service Service
+	repo    *fakeRepository
+	history *fakeHistoryService
+}
+
+// newServiceFixture holds a draft and a published content, with the statuses
+// as seeded.
+func newServiceFixture() serviceFixture {
+	repo := newFakeRepository(
+		&models.ContentModel{ID: draftContentId, AuthorId: "alice", StatusId: draftId},
+		&models.ContentModel{ID: publishedContentId, AuthorId: "alice", StatusId: publishedId},
+	)
+	status := newFakeStatusService(
+		&contentStatusModel.ContentStatus{ID: draftId, Name: contentStatusModel.StatusDraft, IsRemovable: true, IsSystem: true},
+		&contentStatusModel.ContentStatus{ID: publishedId, Name: contentStatusModel.StatusPublished, IsSystem: true},
+	)
+	history := &fakeHistoryService{}
+	return serviceFixture{
+		service: New(repo, fakeSubjectRepository{}, nil, history, status, nil, DegradationStrict),
+		repo:    repo,
+		history: history,
+	}
+}
+
+func TestDelete(t *testing.T) {
+	f := newServiceFixture()
+
+	err := f.service.Delete(withIdentity("alice"), models.IdRequest{ID: draftContentId.Hex()})
+	if err != nil {
+		t.Fatalf("deleting a draft: %v", err)
+	}
+	if _, ok := f.repo.content[draftContentId.Hex()]; ok {
+		t.Fatal("the draft was not deleted")
+	}
+	if len(f.history.entries) != 1 || f.history.entries[0].Action != actionDelete {
+		t.Fatalf("history = %+v, want one deletion", f.history.entries)
+	}
+
+	err = f.service.Delete(withIdentity("alice"), models.IdRequest{ID: publishedContentId.Hex()})
+	assertAppError(t, err, errors.ErrContentIsNotRemovable)
+	if _, ok := f.repo.content[publishedContentId.Hex()]; !ok {
+		t.Fatal("published content was deleted")
+	}
+}
+
+func TestChangeStatusRecordsStatusIds(t *testing.T) {
+	f := newServiceFixture()
+
+	err := f.service.ChangeStatus(withIdentity("alice"), models.ChangeStatusRequest{ID: draftContentId.Hex(), StatusId: publishedId})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if got := f.repo.content[draftContentId.Hex()].StatusId; got != publishedId {
+		t.Fatalf("status_id = %s, want %s", got, publishedId)
+	}
+	if len(f.history.entries) != 1 {
+		t.Fatalf("history = %+v, want one entry", f.history.entries)
+	}
+	entry := f.history.entries[0]
+	if entry.Action != actionChangeStatus || entry.PreviousValue != draftId || entry.NewValue != publishedId {
+		t.Fatalf("history = %+v, want the change from %s to %s", entry, draftId, publishedId)
+	}
+}
+
+func TestGetListFiltersByStatus(t *testing.T) {
+	f := newServiceFixture()
+
+	_, _, err := f.service.GetList(withIdentity("alice"), models.GetListRequest{ContentFilter: models.ContentFilter{Status: draftId}})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, filter := range f.repo.filters {
+		if filter.Status != draftId {
+			t.Fatalf("filter = %+v, want the status %s", filter, draftId)
+		}
+	}
+	if len(f.repo.filters) == 0 {
+		t.Fatal("the repository was not filtered")
+	}
+}
@@ -0,0 +1,63 @@
+package service
+
+import (
+	"context"
+	"github.com/SpawNKZ/content_service/common/auth"
+	repository "github.com/SpawNKZ/content_service/content/repo"
+	contentHistoryModel "github.com/SpawNKZ/content_service/content_history/models"
+	contentHistory "github.com/SpawNKZ/content_service/content_history/service"
+	contentStatusModel "github.com/SpawNKZ/content_service/content_status/models"
+	contentStatus "github.com/SpawNKZ/content_service/content_status/service"
+	"time"
+)
+
+type statusUsage struct {
+	repo    repository.Repository
+	history contentHistory.Service
+}
+
+// NewStatusUsage lets the content status service check and move the content
+// using a status before deleting it.
+func NewStatusUsage(repo repository.Repository, history contentHistory.Service) contentStatus.ContentUsage {
+	return &statusUsage{
+		repo:    repo,
+		history: history,
+	}
+}
+
+func (u *statusUsage) CountByStatus(ctx context.Context, status contentStatusModel.ContentStatus) (int64, error) {
+	return u.repo.CountByStatus(ctx, status.ID)
+}
+
+func (u *statusUsage) MoveToStatus(ctx context.Context, from contentStatusModel.ContentStatus, to contentStatusModel.ContentStatus) (int64, error) {
+	ids, err := u.repo.FindIdsByStatus(ctx, from.ID)
+	if err != nil || len(ids) == 0 {
+		return 0, err
+	}
+
+	moved, err := u.repo.ReplaceStatus(ctx, ids, from.ID, to.ID)
+	if err != nil {
+		return int64(len(moved)), err
+	}
+
+	var userId string
+	if identity, ok := auth.FromContext(ctx); ok {
+		userId = identity.UserId
+	}
+	// Content changed to another status since it was found is not moved.
+	for _, id := range moved {
+		err = u.history.Create(ctx, contentHistoryModel.ContentHistory{
+			ContentId:     id,
+			UserId:        userId,
+			Action:        actionChangeStatus,
+			PreviousValue: from.ID,
+			NewValue:      to.ID,
+			CreatedAt:     time.Now(),
+		})
+		if err != nil {
+			return int64(len(moved)), err
+		}
+	}
+
+	return int64(len(moved)), nil
+}
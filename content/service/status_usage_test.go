@@ -0,0 +1,71 @@
+package service
+
+import (
+	"context"
+	"github.com/SpawNKZ/content_service/common/auth"
+	"github.com/SpawNKZ/content_service/content/models"
+	contentStatusModel "github.com/SpawNKZ/content_service/content_status/models"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"testing"
+)
+
+func TestMoveToStatusRecordsTheAdmin(t *testing.T) {
+	f := newServiceFixture()
+	usage := NewStatusUsage(f.repo, f.history)
+
+	archivedId := "000000000000000000000003"
+	moved, err := usage.MoveToStatus(withIdentity("root", auth.GroupAdmin),
+		contentStatusModel.ContentStatus{ID: draftId, Name: contentStatusModel.StatusDraft},
+		contentStatusModel.ContentStatus{ID: archivedId, Name: contentStatusModel.StatusArchived},
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if moved != 1 || f.repo.content[draftContentId.Hex()].StatusId != archivedId {
+		t.Fatalf("moved %d, want the draft moved to the archived status", moved)
+	}
+	if len(f.history.entries) != 1 {
+		t.Fatalf("history = %+v, want one entry", f.history.entries)
+	}
+	entry := f.history.entries[0]
+	if entry.UserId != "root" || entry.ContentId != draftContentId.Hex() || entry.PreviousValue != draftId || entry.NewValue != archivedId {
+		t.Fatalf("history = %+v, want the move of the draft to %s by root", entry, archivedId)
+	}
+}
+
+func TestMoveToStatusRecordsOnlyTheMovedContent(t *testing.T) {
+	f := newServiceFixture()
+	otherDraftId := primitive.NewObjectID()
+	f.repo.content[otherDraftId.Hex()] = &models.ContentModel{ID: otherDraftId, StatusId: draftId}
+	repo := &publishingRepository{fakeRepository: f.repo, publish: otherDraftId.Hex()}
+	usage := NewStatusUsage(repo, f.history)
+
+	archivedId := "000000000000000000000003"
+	moved, err := usage.MoveToStatus(withIdentity("root", auth.GroupAdmin),
+		contentStatusModel.ContentStatus{ID: draftId, Name: contentStatusModel.StatusDraft},
+		contentStatusModel.ContentStatus{ID: archivedId, Name: contentStatusModel.StatusArchived},
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if moved != 1 || f.repo.content[otherDraftId.Hex()].StatusId != publishedId {
+		t.Fatalf("moved %d, want only the draft that was not published meanwhile", moved)
+	}
+	if len(f.history.entries) != 1 || f.history.entries[0].ContentId != draftContentId.Hex() {
+		t.Fatalf("history = %+v, want the move of %s only", f.history.entries, draftContentId.Hex())
+	}
+}
+
+// publishingRepository publishes a content between the lookup of the content
+// to move and the move, as a concurrent status change would.
+type publishingRepository struct {
+	*fakeRepository
+	publish string
+}
+
+func (r *publishingRepository) ReplaceStatus(ctx context.Context, ids []string, fromStatusId string, toStatusId string) ([]string, error) {
+	r.content[r.publish].StatusId = publishedId
+	return r.fakeRepository.ReplaceStatus(ctx, ids, fromStatusId, toStatusId)
+}
@@ -0,0 +1,154 @@
+package transports
+
+import (
+	"context"
+	"github.com/SpawNKZ/content_service/common/transport"
+	"github.com/SpawNKZ/content_service/common/validation"
+	"github.com/SpawNKZ/content_service/content/endpoints"
+	"github.com/SpawNKZ/content_service/content/models"
+	contentService "github.com/SpawNKZ/content_service/content/service"
+	"github.com/SpawNKZ/content_service/pb"
+	"github.com/go-kit/kit/endpoint"
+	grpcTransport "github.com/go-kit/kit/transport/grpc"
+	"github.com/go-kit/log"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+type grpcServer struct {
+	pb.UnimplementedContentServiceServer
+	create  grpcTransport.Handler
+	getOne  grpcTransport.Handler
+	update  grpcTransport.Handler
+	delete  grpcTransport.Handler
+	getList grpcTransport.Handler
+}
+
+// NewGRPCServer serves the content endpoints over gRPC, guarded by the same
+// middlewares as over HTTP.
+func NewGRPCServer(s contentService.Service, authMiddleware endpoint.Middleware, logger log.Logger) pb.ContentServiceServer {
+	options := transport.GRPCServerOptions(logger)
+	validate := validation.Middleware()
+
+	return &grpcServer{
+		create: grpcTransport.NewServer(
+			authMiddleware(validate(endpoints.MakeCreateEndpoint(s))),
+			decodeGRPCCreateRequest,
+			transport.EncodeGRPCResponse,
+			options...,
+		),
+		getOne: grpcTransport.NewServer(
+			authMiddleware(endpoints.MakeGetOneEndpoint(s)),
+			decodeGRPCIdRequest,
+			transport.EncodeGRPCResponse,
+			options...,
+		),
+		update: grpcTransport.NewServer(
+			authMiddleware(validate(endpoints.MakeUpdateEndpoint(s))),
+			decodeGRPCUpdateRequest,
+			transport.EncodeGRPCResponse,
+			options...,
+		),
+		delete: grpcTransport.NewServer(
+			authMiddleware(endpoints.MakeDeleteOneEndpoint(s)),
+			decodeGRPCIdRequest,
+			transport.EncodeGRPCResponse,
+			options...,
+		),
+		getList: grpcTransport.NewServer(
+			authMiddleware(endpoints.MakeGetListEndpoint(s)),
+			decodeGRPCGetListRequest,
+			transport.EncodeGRPCResponse,
+			options...,
+		),
+	}
+}
+
+func (g *grpcServer) Create(ctx context.Context, req *pb.CreateContentRequest) (*pb.Created, error) {
+	ctx, res, err := g.create.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, transport.GRPCError(ctx, err)
+	}
+	return res.(*pb.Created), nil
+}
+
+func (g *grpcServer) Get(ctx context.Context, req *pb.IdRequest) (*pb.Content, error) {
+	ctx, res, err := g.getOne.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, transport.GRPCError(ctx, err)
+	}
+	return res.(*pb.Content), nil
+}
+
+func (g *grpcServer) Update(ctx context.Context, req *pb.UpdateContentRequest) (*emptypb.Empty, error) {
+	ctx, res, err := g.update.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, transport.GRPCError(ctx, err)
+	}
+	return res.(*emptypb.Empty), nil
+}
+
+func (g *grpcServer) Delete(ctx context.Context, req *pb.IdRequest) (*emptypb.Empty, error) {
+	ctx, res, err := g.delete.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, transport.GRPCError(ctx, err)
+	}
+	return res.(*emptypb.Empty), nil
+}
+
+func (g *grpcServer) List(ctx context.Context, req *pb.ListContentRequest) (*pb.ContentList, error) {
+	ctx, res, err := g.getList.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, transport.GRPCError(ctx, err)
+	}
+	return res.(*pb.ContentList), nil
+}
+
+func decodeGRPCCreateRequest(_ context.Context, grpcReq interface{}) (interface{}, error) {
+	req := grpcReq.(*pb.CreateContentRequest)
+	return models.CreateRequest{
+		Locale:       req.Locale,
+		Body:         req.Body,
+		Description:  req.Description,
+		Resources:    req.Resources,
+		SubjectId:    req.SubjectId,
+		MicrotopicId: req.MicrotopicId,
+		AuthorId:     req.AuthorId,
+		Difficulty:   int(req.Difficulty),
+	}, nil
+}
+
+func decodeGRPCIdRequest(_ context.Context, grpcReq interface{}) (interface{}, error) {
+	req := grpcReq.(*pb.IdRequest)
+	return models.IdRequest{ID: req.Id}, nil
+}
+
+func decodeGRPCUpdateRequest(_ context.Context, grpcReq interface{}) (interface{}, error) {
+	req := grpcReq.(*pb.UpdateContentRequest)
+	update := models.UpdateRequest{
+		ID:          req.Id,
+		Body:        req.Body,
+		Description: req.Description,
+	}
+	if req.Difficulty != nil {
+		difficulty := int(*req.Difficulty)
+		update.Difficulty = &difficulty
+	}
+	return update, nil
+}
+
+func decodeGRPCGetListRequest(_ context.Context, grpcReq interface{}) (interface{}, error) {
+	req := grpcReq.(*pb.ListContentRequest)
+	return models.GetListRequest{
+		ReqPagination: models.ReqPagination{
+			Limit: int(req.Limit), Offset: int(req.Offset),
+		},
+		ContentFilter: models.ContentFilter{
+			Locale:        req.Locale,
+			Status:        req.Status,
+			AuthorId:      req.AuthorId,
+			ContributorId: req.ContributorId,
+			SubjectId:     req.SubjectId,
+			MicrotopicId:  req.MicrotopicId,
+		},
+	}, nil
+}
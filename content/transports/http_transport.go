@@ -2,14 +2,12 @@ package transports
 
 import (
 	"context"
-	"encoding/json"
-	"github.com/SpawNKZ/content_service/common/errors"
+	"github.com/SpawNKZ/content_service/common/transport"
+	"github.com/SpawNKZ/content_service/common/validation"
 	"github.com/SpawNKZ/content_service/content/endpoints"
 	"github.com/SpawNKZ/content_service/content/models"
 	contentService "github.com/SpawNKZ/content_service/content/service"
-	"github.com/go-kit/kit/auth/jwt"
 	"github.com/go-kit/kit/endpoint"
-	"github.com/go-kit/kit/transport"
 	httpTransport "github.com/go-kit/kit/transport/http"
 	"github.com/go-kit/log"
 	"github.com/gorilla/mux"
@@ -17,93 +15,75 @@ import (
 	"strconv"
 )
 
-var (
-	opResult       = &OperationResult{}
-	requiredGroups = []string{"admin"}
-)
-
-type OperationResult struct {
-	Success bool        `json:"success"`
-	Message string      `json:"message"`
-	Data    interface{} `json:"data"`
-}
+var requiredGroups = []string{"admin"}
 
-func (o *OperationResult) Succeed(data interface{}) *OperationResult {
-	o.Success = true
-	o.Message = ""
-	o.Data = data
-	return o
-}
-
-func (o *OperationResult) Error(errorMessage string) *OperationResult {
-	o.Success = false
-	o.Message = errorMessage
-	o.Data = nil
-	return o
-}
-
-// TODO: add auth middleware
-func MakeHTTPHandler(s contentService.Service, logger log.Logger) http.Handler {
+func MakeHTTPHandler(s contentService.Service, authMiddleware endpoint.Middleware, logger log.Logger) http.Handler {
 	r := mux.NewRouter()
-	options := []httpTransport.ServerOption{
-		httpTransport.ServerErrorHandler(transport.NewLogErrorHandler(logger)),
-		httpTransport.ServerErrorEncoder(errorEncoder),
-		httpTransport.ServerBefore(httpTransport.PopulateRequestContext, jwt.HTTPToContext()),
-	}
-	// GET     /programs                           retrieve programs list with all translations
-	// GET     /programs/search?q=asdsadas        retrieve programs by search terms
-	// GET     /programs/:id                       retrieve program by id
-	// POST    /programs                         	create program
-	// PUT     /programs/:id                       update the program
-	// DELETE  /programs/:id                       remove the given program
-
-	// GET     /programs/locale/:locale            retrieve programs list with specific locale
-	// GET     /programs/:id/locale/:locale        retrieve program by id and locale
-	// POST    /programs/locale/:locale            create program for specific locale
-	// PUT     /programs/:id/locale/:locale        update the program with specific locale
-	// DELETE     /programs/:id/locale/:locale        delete the program with specific locale
+	options := transport.ServerOptions(logger)
+	validate := validation.Middleware()
+	// GET     /content                            retrieve content, filtered by locale, status, author, contributor, subject or microtopic
+	// GET     /content/:id                        retrieve content by id
+	// POST    /content                            create content
+	// PUT     /content/:id                        update the content
+	// PUT     /content/assign/:id                 assign the author of the content
+	// PUT     /content/change-status/:id          change the status of the content
+	// POST    /content/:id/contributors           add a contributor to the content
+	// DELETE  /content/:id/contributors/:user_id  remove a contributor from the content
+	// DELETE  /content/:id                        remove the given content
 	sr := r.PathPrefix("/api/v1/content").Subrouter()
 
 	sr.Methods("POST").Path("").Handler(httpTransport.NewServer(
-		endpoints.MakeCreateEndpoint(s),
+		authMiddleware(validate(endpoints.MakeCreateEndpoint(s))),
 		decodeCreateRequest,
-		encodeResponse,
+		transport.EncodeResponse,
 		options...,
 	))
 	sr.Methods("GET").Path("/{id}").Handler(httpTransport.NewServer(
-		endpoints.MakeGetOneEndpoint(s),
+		authMiddleware(endpoints.MakeGetOneEndpoint(s)),
 		decodeGetOneRequest,
-		encodeResponse,
+		transport.EncodeResponse,
 		options...,
 	))
 	sr.Methods("PUT").Path("/{id}").Handler(httpTransport.NewServer(
-		endpoints.MakeUpdateEndpoint(s),
+		authMiddleware(validate(endpoints.MakeUpdateEndpoint(s))),
 		decodeUpdateRequest,
-		encodeResponse,
+		transport.EncodeResponse,
 		options...,
 	))
 	sr.Methods("PUT").Path("/assign/{id}").Handler(httpTransport.NewServer(
-		endpoints.MakeAssignAuthorEndpoint(s),
+		authMiddleware(validate(endpoints.MakeAssignAuthorEndpoint(s))),
 		decodeAssignAuthorRequest,
-		encodeResponse,
+		transport.EncodeResponse,
+		options...,
+	))
+	sr.Methods("POST").Path("/{id}/contributors").Handler(httpTransport.NewServer(
+		authMiddleware(validate(endpoints.MakeAddContributorEndpoint(s))),
+		decodeAddContributorRequest,
+		transport.EncodeResponse,
+		options...,
+	))
+	sr.Methods("DELETE").Path("/{id}/contributors/{user_id}").Handler(httpTransport.NewServer(
+		authMiddleware(validate(endpoints.MakeRemoveContributorEndpoint(s))),
+		decodeRemoveContributorRequest,
+		transport.EncodeResponse,
 		options...,
 	))
 	sr.Methods("PUT").Path("/change-status/{id}").Handler(httpTransport.NewServer(
-		endpoints.MakeChangeStatusEndpoint(s),
+		authMiddleware(validate(endpoints.MakeChangeStatusEndpoint(s))),
 		decodeChangeStatusRequest,
-		encodeResponse,
+		transport.EncodeResponse,
 		options...,
 	))
 	sr.Methods("DELETE").Path("/{id}").Handler(httpTransport.NewServer(
-		endpoints.MakeDeleteOneEndpoint(s),
+		authMiddleware(endpoints.MakeDeleteOneEndpoint(s)),
 		decodeDeleteOneRequest,
-		encodeResponse,
+		transport.EncodeResponse,
 		options...,
 	))
 	sr.Methods("GET").Path("").Handler(httpTransport.NewServer(
-		endpoints.MakeGetListEndpoint(s),
+		authMiddleware(endpoints.MakeGetListEndpoint(s)),
 		decodeGetListRequest,
-		encodeResponse,
+		transport.EncodeResponse,
 		options...,
 	))
 	return r
@@ -111,29 +91,27 @@ func MakeHTTPHandler(s contentService.Service, logger log.Logger) http.Handler {
 
 func decodeCreateRequest(_ context.Context, r *http.Request) (request interface{}, err error) {
 	var req models.CreateRequest
-	if e := json.NewDecoder(r.Body).Decode(&req); e != nil {
-		return nil, e
+	if err := transport.DecodeJSON(r, &req); err != nil {
+		return nil, err
 	}
 	return req, nil
 }
 
 func decodeGetOneRequest(_ context.Context, r *http.Request) (request interface{}, err error) {
-	vars := mux.Vars(r)
-	id, ok := vars["id"]
-	if !ok {
-		return nil, errors.ErrBadRouting
+	id, err := transport.PathVar(r, "id")
+	if err != nil {
+		return nil, err
 	}
 	return models.IdRequest{ID: id}, nil
 }
 
 func decodeUpdateRequest(_ context.Context, r *http.Request) (request interface{}, err error) {
-	vars := mux.Vars(r)
-	id, ok := vars["id"]
-	if !ok {
-		return nil, errors.ErrBadRouting
+	id, err := transport.PathVar(r, "id")
+	if err != nil {
+		return nil, err
 	}
 	var contentUpdate models.UpdateRequest
-	if err := json.NewDecoder(r.Body).Decode(&contentUpdate); err != nil {
+	if err := transport.DecodeJSON(r, &contentUpdate); err != nil {
 		return nil, err
 	}
 
@@ -143,13 +121,12 @@ func decodeUpdateRequest(_ context.Context, r *http.Request) (request interface{
 }
 
 func decodeAssignAuthorRequest(_ context.Context, r *http.Request) (request interface{}, err error) {
-	vars := mux.Vars(r)
-	id, ok := vars["id"]
-	if !ok {
-		return nil, errors.ErrBadRouting
+	id, err := transport.PathVar(r, "id")
+	if err != nil {
+		return nil, err
 	}
 	var contentAssignAuthorUpdate models.AssignAuthorRequest
-	if err := json.NewDecoder(r.Body).Decode(&contentAssignAuthorUpdate); err != nil {
+	if err := transport.DecodeJSON(r, &contentAssignAuthorUpdate); err != nil {
 		return nil, err
 	}
 
@@ -158,14 +135,45 @@ func decodeAssignAuthorRequest(_ context.Context, r *http.Request) (request inte
 	return contentAssignAuthorUpdate, nil
 }
 
+func decodeAddContributorRequest(_ context.Context, r *http.Request) (request interface{}, err error) {
+	id, err := transport.PathVar(r, "id")
+	if err != nil {
+		return nil, err
+	}
+	var contributor models.ContributorRequest
+	if err := transport.DecodeJSON(r, &contributor); err != nil {
+		return nil, err
+	}
+
+	contributor.ID = id
+
+	return contributor, nil
+}
+
+func decodeRemoveContributorRequest(_ context.Context, r *http.Request) (request interface{}, err error) {
+	id, err := transport.PathVar(r, "id")
+	if err != nil {
+		return nil, err
+	}
+	userId, err := transport.PathVar(r, "user_id")
+	if err != nil {
+		return nil, err
+	}
+
+	return models.ContributorRequest{
+		ID:     id,
+		UserId: userId,
+		Role:   r.URL.Query().Get("role"),
+	}, nil
+}
+
 func decodeChangeStatusRequest(_ context.Context, r *http.Request) (request interface{}, err error) {
-	vars := mux.Vars(r)
-	id, ok := vars["id"]
-	if !ok {
-		return nil, errors.ErrBadRouting
+	id, err := transport.PathVar(r, "id")
+	if err != nil {
+		return nil, err
 	}
 	var contentChangeStatusUpdate models.ChangeStatusRequest
-	if err := json.NewDecoder(r.Body).Decode(&contentChangeStatusUpdate); err != nil {
+	if err := transport.DecodeJSON(r, &contentChangeStatusUpdate); err != nil {
 		return nil, err
 	}
 
@@ -174,56 +182,41 @@ func decodeChangeStatusRequest(_ context.Context, r *http.Request) (request inte
 	return contentChangeStatusUpdate, nil
 }
 
-func encodeResponse(ctx context.Context, w http.ResponseWriter, response interface{}) error {
-	if e, ok := response.(endpoint.Failer); ok && e.Failed() != nil {
-		// Not a Go kit transports error, but a business-logic error.
-		// Provide those as HTTP errors.
-		errorEncoder(ctx, e.Failed(), w)
-		return nil
-	}
-	w.Header().Set("Content-Type", "application/json; charset=utf-8")
-	return json.NewEncoder(w).Encode(opResult.Succeed(response))
-}
-
 func decodeDeleteOneRequest(_ context.Context, r *http.Request) (request interface{}, err error) {
-	vars := mux.Vars(r)
-	id, ok := vars["id"]
-	if !ok {
-		return nil, errors.ErrBadRouting
+	id, err := transport.PathVar(r, "id")
+	if err != nil {
+		return nil, err
 	}
 
 	return models.IdRequest{ID: id}, nil
 }
 
 func decodeGetListRequest(_ context.Context, r *http.Request) (request interface{}, err error) {
-	limitStr := r.URL.Query().Get("limit")
-	offsetStr := r.URL.Query().Get("offset")
 	locale := r.URL.Query().Get("locale")
 	status := r.URL.Query().Get("status")
 	authorId := r.URL.Query().Get("author_id")
+	contributorId := r.URL.Query().Get("contributor_id")
 	subjectIdStr := r.URL.Query().Get("subject_id")
 	microtopicIdStr := r.URL.Query().Get("microtopic_id")
 
 	subjectId, _ := strconv.Atoi(subjectIdStr)
 	microtopicId, _ := strconv.Atoi(microtopicIdStr)
-	limit, _ := strconv.Atoi(limitStr)
-	offset, _ := strconv.Atoi(offsetStr)
+	limit, offset, err := transport.Pagination(r)
+	if err != nil {
+		return nil, err
+	}
 
 	return models.GetListRequest{
 		ReqPagination: models.ReqPagination{
 			Limit: limit, Offset: offset,
 		},
 		ContentFilter: models.ContentFilter{
-			Locale:       locale,
-			Status:       status,
-			AuthorId:     authorId,
-			SubjectId:    int64(subjectId),
-			MicrotopicId: int64(microtopicId),
+			Locale:        locale,
+			Status:        status,
+			AuthorId:      authorId,
+			ContributorId: contributorId,
+			SubjectId:     int64(subjectId),
+			MicrotopicId:  int64(microtopicId),
 		},
 	}, nil
 }
-
-func errorEncoder(_ context.Context, err error, w http.ResponseWriter) {
-	w.WriteHeader(errors.ErrorToHttpCode(err))
-	json.NewEncoder(w).Encode(opResult.Error(err.Error()))
-}
@@ -0,0 +1,38 @@
+package transports
+
+import (
+	"github.com/SpawNKZ/content_service/common/openapi"
+	"github.com/SpawNKZ/content_service/content/models"
+)
+
+// OpenAPIOperations describes the routes registered by MakeHTTPHandler.
+func OpenAPIOperations() []openapi.Operation {
+	const tag = "content"
+	id := openapi.PathParam("id")
+	return []openapi.Operation{
+		{Method: "POST", Path: "/api/v1/content", Tag: tag, Summary: "Create content", Request: models.CreateRequest{}, Response: models.CreateResponse{}, Secured: true},
+		{Method: "GET", Path: "/api/v1/content/{id}", Tag: tag, Summary: "Get content by ID", Params: []openapi.Param{id}, Response: models.GetOneResponse{}, Secured: true},
+		{Method: "PUT", Path: "/api/v1/content/{id}", Tag: tag, Summary: "Update content", Params: []openapi.Param{id}, Request: models.UpdateRequest{}, Response: models.UpdateResponse{}, Secured: true},
+		{Method: "PUT", Path: "/api/v1/content/assign/{id}", Tag: tag, Summary: "Assign the author of content", Params: []openapi.Param{id}, Request: models.AssignAuthorRequest{}, Response: models.UpdateResponse{}, Secured: true},
+		{Method: "POST", Path: "/api/v1/content/{id}/contributors", Tag: tag, Summary: "Add a contributor to content", Params: []openapi.Param{id}, Request: models.ContributorRequest{}, Response: models.UpdateResponse{}, Secured: true},
+		{Method: "DELETE", Path: "/api/v1/content/{id}/contributors/{user_id}", Tag: tag, Summary: "Remove a contributor from content",
+			Params: []openapi.Param{
+				id,
+				openapi.PathParam("user_id"),
+				openapi.QueryParam("role", "string", "Role of the contributor to remove."),
+			},
+			Response: models.UpdateResponse{}, Secured: true},
+		{Method: "PUT", Path: "/api/v1/content/change-status/{id}", Tag: tag, Summary: "Change the status of content", Params: []openapi.Param{id}, Request: models.ChangeStatusRequest{}, Response: models.UpdateResponse{}, Secured: true},
+		{Method: "DELETE", Path: "/api/v1/content/{id}", Tag: tag, Summary: "Delete content", Params: []openapi.Param{id}, Response: models.DeleteOneResponse{}, Secured: true},
+		{Method: "GET", Path: "/api/v1/content", Tag: tag, Summary: "List content",
+			Params: append([]openapi.Param{
+				openapi.QueryParam("locale", "string", ""),
+				openapi.QueryParam("status", "string", "Status ID."),
+				openapi.QueryParam("author_id", "string", ""),
+				openapi.QueryParam("contributor_id", "string", ""),
+				openapi.QueryParam("subject_id", "integer", ""),
+				openapi.QueryParam("microtopic_id", "integer", ""),
+			}, openapi.PaginationParams()...),
+			Response: models.GetListResponse{}, Secured: true},
+	}
+}
@@ -0,0 +1,16 @@
+package endpoints
+
+import (
+	"context"
+	"github.com/SpawNKZ/content_service/content_history/models"
+	contentHistoryService "github.com/SpawNKZ/content_service/content_history/service"
+	"github.com/go-kit/kit/endpoint"
+)
+
+func MakeGetListEndpoint(s contentHistoryService.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (response interface{}, err error) {
+		req := request.(models.GetListRequest)
+		res, pagination, e := s.GetList(ctx, req)
+		return models.GetListResponse{ContentHistoryList: res, Pagination: pagination, Err: e}, nil
+	}
+}
@@ -8,6 +8,7 @@ import (
 type ContentHistoryModel struct {
 	ID            primitive.ObjectID `bson:"_id"`
 	ContentId     string             `bson:"content_id"`
+	PostId        string             `bson:"post_id,omitempty"`
 	UserId        string             `bson:"user_id"`
 	Action        string             `bson:"action"`
 	PreviousValue string             `bson:"previous_value"`
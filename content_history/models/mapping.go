@@ -9,6 +9,7 @@ func (ch *ContentHistory) ToRepositoryModel() *ContentHistoryModel {
 	return &ContentHistoryModel{
 		ID:            primitive.NewObjectID(),
 		ContentId:     ch.ContentId,
+		PostId:        ch.PostId,
 		UserId:        ch.UserId,
 		Action:        ch.Action,
 		PreviousValue: ch.PreviousValue,
@@ -20,6 +21,7 @@ func (ch *ContentHistory) ToRepositoryModel() *ContentHistoryModel {
 func (ch *ContentHistory) FromRepositoryModel(repoCh *ContentHistoryModel) {
 	ch.ID = repoCh.ID.Hex()
 	ch.ContentId = repoCh.ContentId
+	ch.PostId = repoCh.PostId
 	ch.UserId = repoCh.UserId
 	ch.Action = repoCh.Action
 	ch.PreviousValue = repoCh.PreviousValue
@@ -1,13 +1,30 @@
 package models
 
-import "time"
+import (
+	"github.com/SpawNKZ/content_service/common/pagination"
+	"time"
+)
 
 type ContentHistory struct {
 	ID            string    `json:"_id"`
 	ContentId     string    `json:"content_id"`
+	PostId        string    `json:"post_id,omitempty"`
 	UserId        string    `json:"user_id"`
 	Action        string    `json:"action"`
 	PreviousValue string    `json:"previous_value"`
 	NewValue      string    `json:"new_value"`
 	CreatedAt     time.Time `json:"created_at"`
 }
+
+type Pagination struct {
+	Total      int  `json:"total"`
+	Limit      int  `json:"limit"`
+	Offset     int  `json:"offset"`
+	IsLastPage bool `json:"isLastPage"`
+}
+
+const (
+	DefaultLimit = pagination.DefaultLimit
+	MaxOffset    = pagination.MaxOffset
+	MaxLimit     = pagination.MaxLimit
+)
@@ -0,0 +1,17 @@
+package models
+
+type ReqPagination struct {
+	Limit  int
+	Offset int
+}
+
+type HistoryFilter struct {
+	ContentId string `bson:"content_id"`
+	PostId    string `bson:"post_id"`
+	Action    string `bson:"action"`
+}
+
+type GetListRequest struct {
+	ReqPagination
+	HistoryFilter
+}
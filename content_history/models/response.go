@@ -6,6 +6,7 @@ import (
 
 var (
 	_ endpoint.Failer = CreateResponse{}
+	_ endpoint.Failer = GetListResponse{}
 )
 
 type CreateResponse struct {
@@ -14,3 +15,11 @@ type CreateResponse struct {
 }
 
 func (r CreateResponse) Failed() error { return r.Err }
+
+type GetListResponse struct {
+	ContentHistoryList []*ContentHistory `json:"content_history,omitempty"`
+	Pagination
+	Err error `json:"error,omitempty"`
+}
+
+func (r GetListResponse) Failed() error { return r.Err }
@@ -3,7 +3,10 @@ package repository
 import (
 	"context"
 	"github.com/SpawNKZ/content_service/content_history/models"
+	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"reflect"
 )
 
 type repository struct {
@@ -12,6 +15,8 @@ type repository struct {
 
 type Repository interface {
 	Insert(ctx context.Context, contentStatusObj models.ContentHistory) error
+	Count(ctx context.Context, filter models.HistoryFilter) (int64, error)
+	GetAll(ctx context.Context, limit int64, offset int64, filter models.HistoryFilter) ([]*models.ContentHistory, error)
 }
 
 func NewRepository(client *mongo.Client) Repository {
@@ -30,3 +35,59 @@ func (r *repository) Insert(ctx context.Context, contentStatusObj models.Content
 
 	return nil
 }
+
+func (r *repository) Count(ctx context.Context, filter models.HistoryFilter) (int64, error) {
+	count, err := r.c.CountDocuments(ctx, buildFilter(filter))
+	if err != nil {
+		return 0, err
+	}
+
+	return count, nil
+}
+
+func (r *repository) GetAll(ctx context.Context, limit int64, offset int64, filter models.HistoryFilter) ([]*models.ContentHistory, error) {
+	opts := options.Find()
+	opts.SetSkip(offset)
+	opts.SetLimit(limit)
+	opts.SetSort(bson.M{"created_at": -1})
+
+	cursor, err := r.c.Find(ctx, buildFilter(filter), opts)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var historyResults []*models.ContentHistory
+
+	for cursor.Next(ctx) {
+		var history models.ContentHistoryModel
+		if err := cursor.Decode(&history); err != nil {
+			return nil, err
+		}
+		var historyRes models.ContentHistory
+		historyRes.FromRepositoryModel(&history)
+		historyResults = append(historyResults, &historyRes)
+	}
+
+	if err := cursor.Err(); err != nil {
+		return nil, err
+	}
+
+	return historyResults, nil
+}
+
+func buildFilter(filter models.HistoryFilter) bson.M {
+	filterQuery := bson.M{}
+	filterValue := reflect.ValueOf(filter)
+	filterType := reflect.TypeOf(filter)
+
+	for i := 0; i < filterValue.NumField(); i++ {
+		field := filterValue.Field(i)
+		if !field.IsZero() {
+			fieldName := filterType.Field(i).Tag.Get("bson")
+			filterQuery[fieldName] = field.Interface()
+		}
+	}
+
+	return filterQuery
+}
@@ -0,0 +1,36 @@
+package service
+
+import (
+	"context"
+	"github.com/SpawNKZ/content_service/common/instrumenting"
+	"github.com/SpawNKZ/content_service/content_history/models"
+	"time"
+)
+
+type instrumentingService struct {
+	metrics instrumenting.RequestMetrics
+	service Service
+}
+
+func NewInstrumentingService(metrics instrumenting.RequestMetrics, service Service) Service {
+	return &instrumentingService{
+		metrics: metrics,
+		service: service,
+	}
+}
+
+func (s *instrumentingService) Create(ctx context.Context, request models.ContentHistory) (err error) {
+	defer func(begin time.Time) {
+		s.metrics.Observe("Create", begin, err)
+	}(time.Now())
+
+	return s.service.Create(ctx, request)
+}
+
+func (s *instrumentingService) GetList(ctx context.Context, request models.GetListRequest) (result []*models.ContentHistory, pagination models.Pagination, err error) {
+	defer func(begin time.Time) {
+		s.metrics.Observe("GetList", begin, err)
+	}(time.Now())
+
+	return s.service.GetList(ctx, request)
+}
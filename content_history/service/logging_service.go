@@ -29,3 +29,15 @@ func (l *loggingService) Create(ctx context.Context, request models.ContentHisto
 
 	return l.service.Create(ctx, request)
 }
+
+func (l *loggingService) GetList(ctx context.Context, request models.GetListRequest) (result []*models.ContentHistory, pagination models.Pagination, err error) {
+	defer func(begin time.Time) {
+		l.logger.Log(
+			"method", "GetList",
+			"content_history", len(result),
+			"err", err,
+		)
+	}(time.Now())
+
+	return l.service.GetList(ctx, request)
+}
@@ -2,12 +2,15 @@ package service
 
 import (
 	"context"
+	"github.com/SpawNKZ/content_service/common/errors"
+	"github.com/SpawNKZ/content_service/common/pagination"
 	"github.com/SpawNKZ/content_service/content_history/models"
 	repository "github.com/SpawNKZ/content_service/content_history/repo"
 )
 
 type Service interface {
 	Create(ctx context.Context, data models.ContentHistory) error
+	GetList(ctx context.Context, data models.GetListRequest) ([]*models.ContentHistory, models.Pagination, error)
 }
 
 type service struct {
@@ -23,3 +26,31 @@ func New(repo repository.Repository) Service {
 func (s *service) Create(ctx context.Context, data models.ContentHistory) error {
 	return s.repo.Insert(ctx, data)
 }
+
+func (s *service) GetList(ctx context.Context, data models.GetListRequest) ([]*models.ContentHistory, models.Pagination, error) {
+	limit, offset, err := pagination.Normalize(data.Limit, data.Offset)
+	if err != nil {
+		return nil, models.Pagination{}, err
+	}
+	data.Limit, data.Offset = limit, offset
+
+	count, err := s.repo.Count(ctx, data.HistoryFilter)
+
+	if err != nil {
+		return nil, models.Pagination{}, errors.ErrDB
+	}
+
+	historyList, err := s.repo.GetAll(ctx, int64(data.Limit), int64(data.Offset), data.HistoryFilter)
+	if err != nil {
+		return nil, models.Pagination{}, errors.ErrDB
+	}
+
+	hasNextPage := int64(data.Offset+data.Limit) < count
+
+	return historyList, models.Pagination{
+		Total:      int(count),
+		Limit:      data.Limit,
+		Offset:     data.Offset,
+		IsLastPage: !hasNextPage,
+	}, nil
+}
@@ -0,0 +1,57 @@
+package transports
+
+import (
+	"context"
+	"github.com/SpawNKZ/content_service/common/auth"
+	"github.com/SpawNKZ/content_service/common/transport"
+	"github.com/SpawNKZ/content_service/content_history/endpoints"
+	"github.com/SpawNKZ/content_service/content_history/models"
+	contentHistoryService "github.com/SpawNKZ/content_service/content_history/service"
+	"github.com/go-kit/kit/endpoint"
+	httpTransport "github.com/go-kit/kit/transport/http"
+	"github.com/go-kit/log"
+	"github.com/gorilla/mux"
+	"net/http"
+)
+
+// requiredGroups may read the history, which holds unpublished changes.
+var requiredGroups = []string{auth.GroupAdmin, auth.GroupReviewer, auth.GroupAuthor}
+
+func MakeHTTPHandler(s contentHistoryService.Service, authMiddleware endpoint.Middleware, logger log.Logger) http.Handler {
+	r := mux.NewRouter()
+	options := transport.ServerOptions(logger)
+	requireGroups := auth.RequireGroups(requiredGroups...)
+	// GET     /content_history                    retrieve history entries, newest first,
+	//                                             filtered by content_id, post_id and action
+	sr := r.PathPrefix("/api/v1/content_history").Subrouter()
+
+	sr.Methods("GET").Path("").Handler(httpTransport.NewServer(
+		authMiddleware(requireGroups(endpoints.MakeGetListEndpoint(s))),
+		decodeGetListRequest,
+		transport.EncodeResponse,
+		options...,
+	))
+	return r
+}
+
+func decodeGetListRequest(_ context.Context, r *http.Request) (request interface{}, err error) {
+	contentId := r.URL.Query().Get("content_id")
+	postId := r.URL.Query().Get("post_id")
+	action := r.URL.Query().Get("action")
+
+	limit, offset, err := transport.Pagination(r)
+	if err != nil {
+		return nil, err
+	}
+
+	return models.GetListRequest{
+		ReqPagination: models.ReqPagination{
+			Limit: limit, Offset: offset,
+		},
+		HistoryFilter: models.HistoryFilter{
+			ContentId: contentId,
+			PostId:    postId,
+			Action:    action,
+		},
+	}, nil
+}
@@ -0,0 +1,19 @@
+package transports
+
+import (
+	"github.com/SpawNKZ/content_service/common/openapi"
+	"github.com/SpawNKZ/content_service/content_history/models"
+)
+
+// OpenAPIOperations describes the routes registered by MakeHTTPHandler.
+func OpenAPIOperations() []openapi.Operation {
+	return []openapi.Operation{
+		{Method: "GET", Path: "/api/v1/content_history", Tag: "content_history", Summary: "List history entries, newest first",
+			Params: append([]openapi.Param{
+				openapi.QueryParam("content_id", "string", ""),
+				openapi.QueryParam("post_id", "string", ""),
+				openapi.QueryParam("action", "string", ""),
+			}, openapi.PaginationParams()...),
+			Response: models.GetListResponse{}, Secured: true},
+	}
+}
@@ -33,7 +33,7 @@ func MakeUpdateEndpoint(s contentStatusService.Service) endpoint.Endpoint {
 
 func MakeDeleteOneEndpoint(s contentStatusService.Service) endpoint.Endpoint {
 	return func(ctx context.Context, request interface{}) (response interface{}, err error) {
-		req := request.(models.IdRequest)
+		req := request.(models.DeleteRequest)
 		e := s.Delete(ctx, req)
 		return models.DeleteOneResponse{Err: e}, nil
 	}
@@ -5,7 +5,17 @@ import (
 )
 
 type ContentStatusModel struct {
-	ID          primitive.ObjectID `bson:"_id"`
-	Name        string             `bson:"name"`
-	IsRemovable bool               `bson:"is_removable"`
+	ID           primitive.ObjectID `bson:"_id"`
+	Name         string             `bson:"name"`
+	IsRemovable  bool               `bson:"is_removable"`
+	IsSystem     bool               `bson:"is_system"`
+	Translations []TranslationModel `bson:"translations"`
+	Order        int                `bson:"order"`
+	Color        string             `bson:"color"`
+	Description  string             `bson:"description"`
+}
+
+type TranslationModel struct {
+	Locale string `bson:"locale"`
+	Name   string `bson:"name"`
 }
@@ -1,14 +1,19 @@
 package models
 
 import (
+	contentModel "github.com/SpawNKZ/content_service/content/models"
 	"go.mongodb.org/mongo-driver/bson/primitive"
 )
 
 func (cs *ContentStatus) ToRepositoryModel() *ContentStatusModel {
 	return &ContentStatusModel{
-		ID:          primitive.NewObjectID(),
-		Name:        cs.Name,
-		IsRemovable: cs.IsRemovable,
+		ID:           primitive.NewObjectID(),
+		Name:         cs.Name,
+		IsRemovable:  cs.IsRemovable,
+		Translations: translationsToRepositoryModel(cs.Translations),
+		Order:        cs.Order,
+		Color:        cs.Color,
+		Description:  cs.Description,
 	}
 }
 
@@ -16,15 +21,33 @@ func (cs *ContentStatus) FromRepositoryModel(repoCs *ContentStatusModel) {
 	cs.ID = repoCs.ID.Hex()
 	cs.Name = repoCs.Name
 	cs.IsRemovable = repoCs.IsRemovable
+	cs.IsSystem = repoCs.IsSystem
+	cs.Order = repoCs.Order
+	cs.Color = repoCs.Color
+	cs.Description = repoCs.Description
+
+	cs.Translations = make([]*contentModel.Translation, 0, len(repoCs.Translations))
+	for _, translation := range repoCs.Translations {
+		cs.Translations = append(cs.Translations, &contentModel.Translation{
+			Locale: translation.Locale,
+			Name:   translation.Name,
+		})
+	}
 }
 
 func (cr *CreateRequest) ToContentStatus() *ContentStatus {
 	return &ContentStatus{
-		Name:        cr.Name,
-		IsRemovable: cr.IsRemovable,
+		Name:         cr.Name,
+		IsRemovable:  cr.IsRemovable,
+		Translations: translationRefs(cr.Translations),
+		Order:        cr.Order,
+		Color:        cr.Color,
+		Description:  cr.Description,
 	}
 }
 
+// ToContentStatus returns the fields to set, the ones left out of the
+// request keep their value.
 func (ur *UpdateRequest) ToContentStatus() map[string]interface{} {
 	args := make(map[string]interface{})
 
@@ -32,5 +55,40 @@ func (ur *UpdateRequest) ToContentStatus() map[string]interface{} {
 		args["name"] = ur.Name
 	}
 
+	if ur.Translations != nil {
+		args["translations"] = translationsToRepositoryModel(translationRefs(ur.Translations))
+	}
+
+	if ur.Order != nil {
+		args["order"] = *ur.Order
+	}
+
+	if ur.Color != nil {
+		args["color"] = *ur.Color
+	}
+
+	if ur.Description != nil {
+		args["description"] = *ur.Description
+	}
+
 	return args
 }
+
+func translationRefs(translations []contentModel.Translation) []*contentModel.Translation {
+	refs := make([]*contentModel.Translation, 0, len(translations))
+	for i := range translations {
+		refs = append(refs, &translations[i])
+	}
+	return refs
+}
+
+func translationsToRepositoryModel(translations []*contentModel.Translation) []TranslationModel {
+	repoTranslations := make([]TranslationModel, 0, len(translations))
+	for _, translation := range translations {
+		repoTranslations = append(repoTranslations, TranslationModel{
+			Locale: translation.Locale,
+			Name:   translation.Name,
+		})
+	}
+	return repoTranslations
+}
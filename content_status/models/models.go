@@ -1,7 +1,44 @@
 package models
 
+import (
+	contentModel "github.com/SpawNKZ/content_service/content/models"
+)
+
+// System statuses are looked up by name by the services, they are seeded at
+// startup and cannot be renamed or deleted.
+const (
+	StatusDraft     = "draft"
+	StatusPublished = "published"
+	StatusArchived  = "archived"
+)
+
+var SystemStatuses = []string{StatusDraft, StatusPublished, StatusArchived}
+
 type ContentStatus struct {
-	ID          string `json:"id"`
-	Name        string `json:"name"`
-	IsRemovable bool   `json:"is_removable"`
+	ID           string                      `json:"id"`
+	Name         string                      `json:"name"`
+	IsRemovable  bool                        `json:"is_removable"`
+	IsSystem     bool                        `json:"is_system"`
+	Translations []*contentModel.Translation `json:"translations"`
+	Order        int                         `json:"order"`
+	Color        string                      `json:"color"`
+	Description  string                      `json:"description"`
+	// Label is the name in the requested locale, falling back to Name. It is
+	// only set when a locale is requested.
+	Label string `json:"label,omitempty"`
+}
+
+// Localize keeps the translation of the status in locale only and sets its
+// label.
+func (cs *ContentStatus) Localize(locale string) {
+	cs.Label = cs.Name
+
+	var translations []*contentModel.Translation
+	for _, translation := range cs.Translations {
+		if translation.Locale == locale {
+			translations = append(translations, translation)
+			cs.Label = translation.Name
+		}
+	}
+	cs.Translations = translations
 }
@@ -0,0 +1,55 @@
+package models
+
+import (
+	contentModel "github.com/SpawNKZ/content_service/content/models"
+	"reflect"
+	"testing"
+)
+
+func TestLocalize(t *testing.T) {
+	tests := []struct {
+		locale           string
+		wantLabel        string
+		wantTranslations []*contentModel.Translation
+	}{
+		{"ru", "На проверке", []*contentModel.Translation{{Locale: "ru", Name: "На проверке"}}},
+		{"kk", "Тексеруде", []*contentModel.Translation{{Locale: "kk", Name: "Тексеруде"}}},
+		{"en", "review", nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.locale, func(t *testing.T) {
+			status := ContentStatus{Name: "review", Translations: []*contentModel.Translation{
+				{Locale: "ru", Name: "На проверке"},
+				{Locale: "kk", Name: "Тексеруде"},
+			}}
+
+			status.Localize(tt.locale)
+
+			if status.Label != tt.wantLabel {
+				t.Errorf("label = %q, want %q", status.Label, tt.wantLabel)
+			}
+			if !reflect.DeepEqual(status.Translations, tt.wantTranslations) {
+				t.Errorf("translations = %v, want %v", status.Translations, tt.wantTranslations)
+			}
+		})
+	}
+}
+
+func TestUpdateRequestToContentStatus(t *testing.T) {
+	rename := UpdateRequest{Name: "in review"}
+	if got, want := rename.ToContentStatus(), map[string]interface{}{"name": "in review"}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("rename sets %v, want %v", got, want)
+	}
+
+	order, color, description := 0, "", ""
+	clear := UpdateRequest{Translations: []contentModel.Translation{}, Order: &order, Color: &color, Description: &description}
+	want := map[string]interface{}{
+		"translations": []TranslationModel{},
+		"order":        0,
+		"color":        "",
+		"description":  "",
+	}
+	if got := clear.ToContentStatus(); !reflect.DeepEqual(got, want) {
+		t.Fatalf("clearing sets %v, want %v", got, want)
+	}
+}
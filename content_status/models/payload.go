@@ -1,17 +1,41 @@
 package models
 
+import (
+	contentModel "github.com/SpawNKZ/content_service/content/models"
+)
+
 type CreateRequest struct {
-	Name        string `json:"name"`
-	IsRemovable bool   `json:"is_removable"`
+	Name         string                     `json:"name" validate:"required,max=50"`
+	IsRemovable  bool                       `json:"is_removable"`
+	Translations []contentModel.Translation `json:"translations" validate:"unique=Locale,dive"`
+	Order        int                        `json:"order" validate:"min=0"`
+	Color        string                     `json:"color" validate:"omitempty,hexcolor"`
+	Description  string                     `json:"description" validate:"max=500"`
 }
 
+// IdRequest gets a status. With a Locale, only the translation in that
+// locale is returned and the label is set.
 type IdRequest struct {
-	ID string
+	ID     string
+	Locale string `json:"locale" validate:"omitempty,locale"`
+}
+
+// DeleteRequest deletes a status. The content using it, if any, is moved to
+// the MigrateTo status first; without it the deletion is refused.
+type DeleteRequest struct {
+	ID        string
+	MigrateTo string
 }
 
+// UpdateRequest changes the fields it carries, the nil ones are left as they
+// are. Translations are replaced as a whole.
 type UpdateRequest struct {
-	ID   string
-	Name string `json:"name"`
+	ID           string
+	Name         string                     `json:"name" validate:"required,max=50"`
+	Translations []contentModel.Translation `json:"translations" validate:"omitempty,unique=Locale,dive"`
+	Order        *int                       `json:"order" validate:"omitempty,min=0"`
+	Color        *string                    `json:"color" validate:"omitempty,hexcolor|len=0"`
+	Description  *string                    `json:"description" validate:"omitempty,max=500"`
 }
 
 type ReqPagination struct {
@@ -21,4 +45,12 @@ type ReqPagination struct {
 
 type GetListRequest struct {
 	ReqPagination
+	ContentStatusFilter
+	Locale string `json:"locale" validate:"omitempty,locale"`
+}
+
+// ContentStatusFilter narrows the list of statuses, the empty fields match
+// every status.
+type ContentStatusFilter struct {
+	Name string `json:"name" bson:"name"`
 }
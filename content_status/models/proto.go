@@ -0,0 +1,62 @@
+package models
+
+import (
+	"github.com/SpawNKZ/content_service/pb"
+	"google.golang.org/protobuf/proto"
+)
+
+func (cs *ContentStatus) ToProto() *pb.ContentStatus {
+	translations := make([]*pb.Translation, 0, len(cs.Translations))
+	for _, translation := range cs.Translations {
+		translations = append(translations, translation.ToProto())
+	}
+
+	return &pb.ContentStatus{
+		Id:           cs.ID,
+		Name:         cs.Name,
+		IsRemovable:  cs.IsRemovable,
+		IsSystem:     cs.IsSystem,
+		Translations: translations,
+		Order:        int32(cs.Order),
+		Color:        cs.Color,
+		Description:  cs.Description,
+		Label:        cs.Label,
+	}
+}
+
+func (p Pagination) ToProto() *pb.Pagination {
+	return &pb.Pagination{
+		Total:      int32(p.Total),
+		Limit:      int32(p.Limit),
+		Offset:     int32(p.Offset),
+		IsLastPage: p.IsLastPage,
+	}
+}
+
+func (r CreateResponse) ToProto() proto.Message {
+	return &pb.Created{Id: r.ID}
+}
+
+func (r GetOneResponse) ToProto() proto.Message {
+	return r.ContentStatus.ToProto()
+}
+
+func (r UpdateResponse) ToProto() proto.Message {
+	return nil
+}
+
+func (r DeleteOneResponse) ToProto() proto.Message {
+	return nil
+}
+
+func (r GetListResponse) ToProto() proto.Message {
+	contentStatusList := make([]*pb.ContentStatus, 0, len(r.ContentStatusList))
+	for _, contentStatusObj := range r.ContentStatusList {
+		contentStatusList = append(contentStatusList, contentStatusObj.ToProto())
+	}
+
+	return &pb.ContentStatusList{
+		ContentStatus: contentStatusList,
+		Pagination:    r.Pagination.ToProto(),
+	}
+}
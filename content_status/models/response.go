@@ -1,6 +1,7 @@
 package models
 
 import (
+	"github.com/SpawNKZ/content_service/common/pagination"
 	"github.com/go-kit/kit/endpoint"
 )
 
@@ -52,9 +53,9 @@ type Pagination struct {
 }
 
 const (
-	DefaultLimit = 10
-	MaxOffset    = 2147483647
-	MaxLimit     = 1000
+	DefaultLimit = pagination.DefaultLimit
+	MaxOffset    = pagination.MaxOffset
+	MaxLimit     = pagination.MaxLimit
 )
 
 func (r GetListResponse) Failed() error { return r.Err }
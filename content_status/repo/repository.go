@@ -20,8 +20,9 @@ type Repository interface {
 	FindByName(ctx context.Context, name string) (*models.ContentStatus, error)
 	Update(ctx context.Context, updateObj models.UpdateRequest) error
 	DeleteByID(ctx context.Context, id string) error
-	Count(ctx context.Context) (int64, error)
-	GetAll(ctx context.Context, limit int64, offset int64) ([]*models.ContentStatus, error)
+	Count(ctx context.Context, filter models.ContentStatusFilter) (int64, error)
+	GetAll(ctx context.Context, limit int64, offset int64, filter models.ContentStatusFilter) ([]*models.ContentStatus, error)
+	EnsureSystem(ctx context.Context, name string, removable bool) error
 }
 
 func NewRepository(client *mongo.Client) Repository {
@@ -34,6 +35,9 @@ func (r *repository) Insert(ctx context.Context, contentStatusObj models.Content
 	contentStatusModel := contentStatusObj.ToRepositoryModel()
 
 	result, err := r.c.InsertOne(ctx, contentStatusModel)
+	if mongo.IsDuplicateKeyError(err) {
+		return "", errors.ErrAlreadyExists.Wrap(err)
+	}
 	if err != nil {
 		return "", err
 	}
@@ -44,7 +48,7 @@ func (r *repository) Insert(ctx context.Context, contentStatusObj models.Content
 func (r *repository) FindByID(ctx context.Context, id string) (*models.ContentStatus, error) {
 	objectId, err := primitive.ObjectIDFromHex(id)
 	if err != nil {
-		return nil, err
+		return nil, errors.ErrInvalidID.Wrap(err)
 	}
 
 	filter := bson.D{{"_id", objectId}}
@@ -82,8 +86,16 @@ func (r *repository) FindByName(ctx context.Context, name string) (*models.Conte
 	}
 }
 
-func (r *repository) Count(ctx context.Context) (int64, error) {
+func filterQuery(filter models.ContentStatusFilter) bson.M {
 	filterQuery := bson.M{}
+	if filter.Name != "" {
+		filterQuery["name"] = filter.Name
+	}
+	return filterQuery
+}
+
+func (r *repository) Count(ctx context.Context, filter models.ContentStatusFilter) (int64, error) {
+	filterQuery := filterQuery(filter)
 	count, err := r.c.CountDocuments(ctx, filterQuery)
 	if err != nil {
 		return 0, err
@@ -92,10 +104,11 @@ func (r *repository) Count(ctx context.Context) (int64, error) {
 	return count, nil
 }
 
-func (r *repository) GetAll(ctx context.Context, limit int64, offset int64) ([]*models.ContentStatus, error) {
-	filterQuery := bson.M{}
+func (r *repository) GetAll(ctx context.Context, limit int64, offset int64, filter models.ContentStatusFilter) ([]*models.ContentStatus, error) {
+	filterQuery := filterQuery(filter)
 
 	opts := options.Find()
+	opts.SetSort(bson.D{{Key: "order", Value: 1}, {Key: "_id", Value: 1}})
 	opts.SetSkip(offset)
 	opts.SetLimit(limit)
 
@@ -132,7 +145,7 @@ func (r *repository) GetAll(ctx context.Context, limit int64, offset int64) ([]*
 func (r *repository) Update(ctx context.Context, updateObj models.UpdateRequest) error {
 	objectId, err := primitive.ObjectIDFromHex(updateObj.ID)
 	if err != nil {
-		return err
+		return errors.ErrInvalidID.Wrap(err)
 	}
 	filter := bson.D{{"_id", objectId}}
 
@@ -144,10 +157,13 @@ func (r *repository) Update(ctx context.Context, updateObj models.UpdateRequest)
 	}
 
 	updateResult, err := r.c.UpdateOne(ctx, filter, bson.D{{"$set", update}})
+	if mongo.IsDuplicateKeyError(err) {
+		return errors.ErrAlreadyExists.Wrap(err)
+	}
 	if err != nil {
 		return err
 	}
-	if updateResult.ModifiedCount == 0 {
+	if updateResult.MatchedCount == 0 {
 		return errors.ErrNotFound
 	}
 	return nil
@@ -156,7 +172,7 @@ func (r *repository) Update(ctx context.Context, updateObj models.UpdateRequest)
 func (r *repository) DeleteByID(ctx context.Context, id string) error {
 	objectId, err := primitive.ObjectIDFromHex(id)
 	if err != nil {
-		return err
+		return errors.ErrInvalidID.Wrap(err)
 	}
 	filter := bson.D{{"_id", objectId}}
 
@@ -169,3 +185,18 @@ func (r *repository) DeleteByID(ctx context.Context, id string) error {
 	}
 	return nil
 }
+
+// EnsureSystem creates the status with the given name unless it exists, and
+// marks it as a system status that cannot be removed. removable only applies
+// to a status created here, operators may change it afterwards.
+func (r *repository) EnsureSystem(ctx context.Context, name string, removable bool) error {
+	_, err := r.c.UpdateOne(ctx,
+		bson.M{"name": name},
+		bson.M{
+			"$set":         bson.M{"is_system": true},
+			"$setOnInsert": bson.M{"_id": primitive.NewObjectID(), "is_removable": removable},
+		},
+		options.Update().SetUpsert(true),
+	)
+	return err
+}
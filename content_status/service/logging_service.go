@@ -67,11 +67,12 @@ func (l *loggingService) Update(ctx context.Context, request models.UpdateReques
 	return l.service.Update(ctx, request)
 }
 
-func (l *loggingService) Delete(ctx context.Context, request models.IdRequest) (err error) {
+func (l *loggingService) Delete(ctx context.Context, request models.DeleteRequest) (err error) {
 	defer func(begin time.Time) {
 		l.logger.Log(
 			"method", "Delete",
 			"id", request.ID,
+			"migrate_to", request.MigrateTo,
 			"err", err,
 		)
 	}(time.Now())
@@ -3,6 +3,7 @@ package service
 import (
 	"context"
 	"github.com/SpawNKZ/content_service/common/errors"
+	"github.com/SpawNKZ/content_service/common/pagination"
 	"github.com/SpawNKZ/content_service/content_status/models"
 	repository "github.com/SpawNKZ/content_service/content_status/repo"
 )
@@ -12,17 +13,28 @@ type Service interface {
 	GetOne(ctx context.Context, data models.IdRequest) (*models.ContentStatus, error)
 	GetByName(ctx context.Context, name string) (*models.ContentStatus, error)
 	Update(ctx context.Context, data models.UpdateRequest) error
-	Delete(ctx context.Context, data models.IdRequest) error
+	Delete(ctx context.Context, data models.DeleteRequest) error
 	GetList(ctx context.Context, data models.GetListRequest) ([]*models.ContentStatus, models.Pagination, error)
 }
 
+// ContentUsage looks up the content using a status. It is implemented by the
+// content package, which depends on this one.
+type ContentUsage interface {
+	CountByStatus(ctx context.Context, status models.ContentStatus) (int64, error)
+	// MoveToStatus moves the content using from to the to status and
+	// reports how many were moved.
+	MoveToStatus(ctx context.Context, from models.ContentStatus, to models.ContentStatus) (int64, error)
+}
+
 type service struct {
-	repo repository.Repository
+	repo  repository.Repository
+	usage ContentUsage
 }
 
-func New(repo repository.Repository) Service {
+func New(repo repository.Repository, usage ContentUsage) Service {
 	return &service{
-		repo: repo,
+		repo:  repo,
+		usage: usage,
 	}
 }
 
@@ -31,7 +43,14 @@ func (s *service) Create(ctx context.Context, data models.CreateRequest) (string
 }
 
 func (s *service) GetOne(ctx context.Context, data models.IdRequest) (*models.ContentStatus, error) {
-	return s.repo.FindByID(ctx, data.ID)
+	contentStatusObj, err := s.repo.FindByID(ctx, data.ID)
+	if err != nil {
+		return nil, err
+	}
+	if data.Locale != "" {
+		contentStatusObj.Localize(data.Locale)
+	}
+	return contentStatusObj, nil
 }
 
 func (s *service) GetByName(ctx context.Context, name string) (*models.ContentStatus, error) {
@@ -39,17 +58,29 @@ func (s *service) GetByName(ctx context.Context, name string) (*models.ContentSt
 }
 
 func (s *service) GetList(ctx context.Context, data models.GetListRequest) ([]*models.ContentStatus, models.Pagination, error) {
-	count, err := s.repo.Count(ctx)
+	limit, offset, err := pagination.Normalize(data.Limit, data.Offset)
+	if err != nil {
+		return nil, models.Pagination{}, err
+	}
+	data.Limit, data.Offset = limit, offset
+
+	count, err := s.repo.Count(ctx, data.ContentStatusFilter)
 
 	if err != nil {
 		return nil, models.Pagination{}, errors.ErrDB
 	}
 
-	contentStatusList, err := s.repo.GetAll(ctx, int64(data.Limit), int64(data.Offset))
+	contentStatusList, err := s.repo.GetAll(ctx, int64(data.Limit), int64(data.Offset), data.ContentStatusFilter)
 	if err != nil {
 		return nil, models.Pagination{}, errors.ErrDB
 	}
 
+	if data.Locale != "" {
+		for _, contentStatusObj := range contentStatusList {
+			contentStatusObj.Localize(data.Locale)
+		}
+	}
+
 	hasNextPage := int64(data.Offset+data.Limit) < count
 
 	return contentStatusList, models.Pagination{
@@ -61,9 +92,60 @@ func (s *service) GetList(ctx context.Context, data models.GetListRequest) ([]*m
 }
 
 func (s *service) Update(ctx context.Context, data models.UpdateRequest) error {
+	contentStatusObj, err := s.repo.FindByID(ctx, data.ID)
+	if err != nil {
+		return err
+	}
+	if contentStatusObj.IsSystem && data.Name != contentStatusObj.Name {
+		return errors.ErrSystemStatus
+	}
+
 	return s.repo.Update(ctx, data)
 }
 
-func (s *service) Delete(ctx context.Context, data models.IdRequest) error {
+func (s *service) Delete(ctx context.Context, data models.DeleteRequest) error {
+	contentStatusObj, err := s.repo.FindByID(ctx, data.ID)
+	if err != nil {
+		return err
+	}
+	if contentStatusObj.IsSystem {
+		return errors.ErrSystemStatus
+	}
+
+	if data.MigrateTo != "" {
+		target, err := s.repo.FindByID(ctx, data.MigrateTo)
+		if err != nil {
+			return err
+		}
+		if target.ID == contentStatusObj.ID {
+			return errors.ErrInconsistentIDs.WithMessage("cannot migrate content to the status being deleted")
+		}
+		if _, err := s.usage.MoveToStatus(ctx, *contentStatusObj, *target); err != nil {
+			return err
+		}
+	}
+
+	// Counted after the move, so that content put in the status meanwhile
+	// still blocks the deletion.
+	count, err := s.usage.CountByStatus(ctx, *contentStatusObj)
+	if err != nil {
+		return err
+	}
+	if count > 0 {
+		return errors.ErrStatusInUse.WithDetails(map[string]interface{}{"content_count": count})
+	}
+
 	return s.repo.DeleteByID(ctx, data.ID)
 }
+
+// Seed creates the system statuses that do not exist yet and flags the ones
+// that do. It is safe to run on every startup. Only drafts are created
+// removable, published and archived content is kept.
+func Seed(ctx context.Context, repo repository.Repository) error {
+	for _, name := range models.SystemStatuses {
+		if err := repo.EnsureSystem(ctx, name, name == models.StatusDraft); err != nil {
+			return err
+		}
+	}
+	return nil
+}
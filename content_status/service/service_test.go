@@ -0,0 +1,173 @@
+package service
+
+import (
+	"context"
+	"github.com/SpawNKZ/content_service/common/errors"
+	"github.com/SpawNKZ/content_service/content_status/models"
+	"testing"
+)
+
+func TestSeed(t *testing.T) {
+	repo := newFakeRepository()
+	// An operator made published content removable, seeding must keep it.
+	repo.statuses["kept"] = &models.ContentStatus{ID: "kept", Name: models.StatusPublished, IsRemovable: true}
+
+	if err := Seed(context.Background(), repo); err != nil {
+		t.Fatal(err)
+	}
+	if err := Seed(context.Background(), repo); err != nil {
+		t.Fatalf("second seed: %v", err)
+	}
+
+	want := map[string]bool{
+		models.StatusDraft:     true,
+		models.StatusPublished: true,
+		models.StatusArchived:  false,
+	}
+	if len(repo.statuses) != len(want) {
+		t.Fatalf("seeded %d statuses, want %d", len(repo.statuses), len(want))
+	}
+	for name, removable := range want {
+		statusObj, err := repo.FindByName(context.Background(), name)
+		if err != nil {
+			t.Fatalf("%s: %v", name, err)
+		}
+		if !statusObj.IsSystem || statusObj.IsRemovable != removable {
+			t.Errorf("%s: is_system = %v, is_removable = %v, want true, %v", name, statusObj.IsSystem, statusObj.IsRemovable, removable)
+		}
+	}
+}
+
+func TestDelete(t *testing.T) {
+	const (
+		reviewId  = "review"
+		draftId   = "draft"
+		defunctId = "defunct"
+	)
+	tests := []struct {
+		name      string
+		data      models.DeleteRequest
+		inUse     int64
+		want      *errors.AppError
+		wantMoved bool
+	}{
+		{"system status", models.DeleteRequest{ID: draftId}, 0, errors.ErrSystemStatus, false},
+		{"unused", models.DeleteRequest{ID: reviewId}, 0, nil, false},
+		{"in use", models.DeleteRequest{ID: reviewId}, 2, errors.ErrStatusInUse, false},
+		{"migrated", models.DeleteRequest{ID: reviewId, MigrateTo: draftId}, 2, nil, true},
+		{"migrated to itself", models.DeleteRequest{ID: reviewId, MigrateTo: reviewId}, 2, errors.ErrInconsistentIDs, false},
+		{"migrated to a missing status", models.DeleteRequest{ID: reviewId, MigrateTo: defunctId}, 2, errors.ErrNotFound, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := newFakeRepository(
+				&models.ContentStatus{ID: draftId, Name: models.StatusDraft, IsSystem: true},
+				&models.ContentStatus{ID: reviewId, Name: "review"},
+			)
+			usage := &fakeUsage{count: map[string]int64{reviewId: tt.inUse}}
+
+			err := New(repo, usage).Delete(context.Background(), tt.data)
+			if tt.want == nil && err != nil || tt.want != nil && !errors.Is(err, tt.want) {
+				t.Fatalf("err = %v, want %v", err, tt.want)
+			}
+			if deleted := len(repo.deleted) == 1; deleted != (tt.want == nil) {
+				t.Fatalf("deleted = %v, want %v", deleted, tt.want == nil)
+			}
+			if moved := len(usage.moves) == 1; moved != tt.wantMoved {
+				t.Fatalf("moves = %v, want moved %v", usage.moves, tt.wantMoved)
+			}
+		})
+	}
+}
+
+// fakeUsage counts the content per status ID and moves it on request.
+type fakeUsage struct {
+	count map[string]int64
+	moves []string
+}
+
+func (u *fakeUsage) CountByStatus(_ context.Context, status models.ContentStatus) (int64, error) {
+	return u.count[status.ID], nil
+}
+
+func (u *fakeUsage) MoveToStatus(_ context.Context, from models.ContentStatus, to models.ContentStatus) (int64, error) {
+	moved := u.count[from.ID]
+	u.count[to.ID] += moved
+	u.count[from.ID] = 0
+	u.moves = append(u.moves, from.ID+"->"+to.ID)
+	return moved, nil
+}
+
+// fakeRepository holds the statuses keyed by ID, EnsureSystem behaves as the
+// upsert of the repository.
+type fakeRepository struct {
+	statuses map[string]*models.ContentStatus
+	deleted  []string
+}
+
+func newFakeRepository(statuses ...*models.ContentStatus) *fakeRepository {
+	r := &fakeRepository{statuses: map[string]*models.ContentStatus{}}
+	for _, statusObj := range statuses {
+		r.statuses[statusObj.ID] = statusObj
+	}
+	return r
+}
+
+func (r *fakeRepository) Insert(_ context.Context, statusObj models.ContentStatus) (string, error) {
+	statusObj.ID = "status-" + statusObj.Name
+	r.statuses[statusObj.ID] = &statusObj
+	return statusObj.ID, nil
+}
+
+func (r *fakeRepository) FindByID(_ context.Context, id string) (*models.ContentStatus, error) {
+	statusObj, ok := r.statuses[id]
+	if !ok {
+		return nil, errors.ErrNotFound
+	}
+	copied := *statusObj
+	return &copied, nil
+}
+
+func (r *fakeRepository) FindByName(_ context.Context, name string) (*models.ContentStatus, error) {
+	for _, statusObj := range r.statuses {
+		if statusObj.Name == name {
+			copied := *statusObj
+			return &copied, nil
+		}
+	}
+	return nil, errors.ErrNotFound
+}
+
+func (r *fakeRepository) Update(context.Context, models.UpdateRequest) error {
+	return nil
+}
+
+func (r *fakeRepository) DeleteByID(_ context.Context, id string) error {
+	delete(r.statuses, id)
+	r.deleted = append(r.deleted, id)
+	return nil
+}
+
+func (r *fakeRepository) Count(context.Context, models.ContentStatusFilter) (int64, error) {
+	return int64(len(r.statuses)), nil
+}
+
+func (r *fakeRepository) GetAll(context.Context, int64, int64, models.ContentStatusFilter) ([]*models.ContentStatus, error) {
+	return nil, nil
+}
+
+func (r *fakeRepository) EnsureSystem(ctx context.Context, name string, removable bool) error {
+	statusObj, err := r.FindByName(ctx, name)
+	if errors.Is(err, errors.ErrNotFound) {
+		_, err = r.Insert(ctx, models.ContentStatus{Name: name, IsRemovable: removable})
+		if err != nil {
+			return err
+		}
+		statusObj, err = r.FindByName(ctx, name)
+	}
+	if err != nil {
+		return err
+	}
+	r.statuses[statusObj.ID].IsSystem = true
+	return nil
+}
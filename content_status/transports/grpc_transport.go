@@ -0,0 +1,172 @@
+package transports
+
+import (
+	"context"
+	"github.com/SpawNKZ/content_service/common/auth"
+	"github.com/SpawNKZ/content_service/common/transport"
+	"github.com/SpawNKZ/content_service/common/validation"
+	contentModel "github.com/SpawNKZ/content_service/content/models"
+	"github.com/SpawNKZ/content_service/content_status/endpoints"
+	"github.com/SpawNKZ/content_service/content_status/models"
+	contentStatusService "github.com/SpawNKZ/content_service/content_status/service"
+	"github.com/SpawNKZ/content_service/pb"
+	"github.com/go-kit/kit/endpoint"
+	grpcTransport "github.com/go-kit/kit/transport/grpc"
+	"github.com/go-kit/log"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+type grpcServer struct {
+	pb.UnimplementedContentStatusServiceServer
+	create  grpcTransport.Handler
+	getOne  grpcTransport.Handler
+	update  grpcTransport.Handler
+	delete  grpcTransport.Handler
+	getList grpcTransport.Handler
+}
+
+// NewGRPCServer serves the content status endpoints over gRPC, validated and
+// authorized as over HTTP.
+func NewGRPCServer(s contentStatusService.Service, authMiddleware endpoint.Middleware, logger log.Logger) pb.ContentStatusServiceServer {
+	options := transport.GRPCServerOptions(logger)
+	validate := validation.Middleware()
+	requireGroups := auth.RequireGroups(requiredGroups...)
+
+	return &grpcServer{
+		create: grpcTransport.NewServer(
+			authMiddleware(requireGroups(validate(endpoints.MakeCreateEndpoint(s)))),
+			decodeGRPCCreateRequest,
+			transport.EncodeGRPCResponse,
+			options...,
+		),
+		getOne: grpcTransport.NewServer(
+			authMiddleware(validate(endpoints.MakeGetOneEndpoint(s))),
+			decodeGRPCGetOneRequest,
+			transport.EncodeGRPCResponse,
+			options...,
+		),
+		update: grpcTransport.NewServer(
+			authMiddleware(requireGroups(validate(endpoints.MakeUpdateEndpoint(s)))),
+			decodeGRPCUpdateRequest,
+			transport.EncodeGRPCResponse,
+			options...,
+		),
+		delete: grpcTransport.NewServer(
+			authMiddleware(requireGroups(endpoints.MakeDeleteOneEndpoint(s))),
+			decodeGRPCDeleteRequest,
+			transport.EncodeGRPCResponse,
+			options...,
+		),
+		getList: grpcTransport.NewServer(
+			authMiddleware(validate(endpoints.MakeGetListEndpoint(s))),
+			decodeGRPCGetListRequest,
+			transport.EncodeGRPCResponse,
+			options...,
+		),
+	}
+}
+
+func (g *grpcServer) Create(ctx context.Context, req *pb.CreateContentStatusRequest) (*pb.Created, error) {
+	ctx, res, err := g.create.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, transport.GRPCError(ctx, err)
+	}
+	return res.(*pb.Created), nil
+}
+
+func (g *grpcServer) Get(ctx context.Context, req *pb.GetContentStatusRequest) (*pb.ContentStatus, error) {
+	ctx, res, err := g.getOne.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, transport.GRPCError(ctx, err)
+	}
+	return res.(*pb.ContentStatus), nil
+}
+
+func (g *grpcServer) Update(ctx context.Context, req *pb.UpdateContentStatusRequest) (*emptypb.Empty, error) {
+	ctx, res, err := g.update.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, transport.GRPCError(ctx, err)
+	}
+	return res.(*emptypb.Empty), nil
+}
+
+func (g *grpcServer) Delete(ctx context.Context, req *pb.IdRequest) (*emptypb.Empty, error) {
+	ctx, res, err := g.delete.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, transport.GRPCError(ctx, err)
+	}
+	return res.(*emptypb.Empty), nil
+}
+
+func (g *grpcServer) List(ctx context.Context, req *pb.ListContentStatusRequest) (*pb.ContentStatusList, error) {
+	ctx, res, err := g.getList.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, transport.GRPCError(ctx, err)
+	}
+	return res.(*pb.ContentStatusList), nil
+}
+
+func decodeGRPCCreateRequest(_ context.Context, grpcReq interface{}) (interface{}, error) {
+	req := grpcReq.(*pb.CreateContentStatusRequest)
+	return models.CreateRequest{
+		Name:         req.Name,
+		IsRemovable:  req.IsRemovable,
+		Translations: decodeGRPCTranslations(req.Translations),
+		Order:        int(req.Order),
+		Color:        req.Color,
+		Description:  req.Description,
+	}, nil
+}
+
+func decodeGRPCGetOneRequest(_ context.Context, grpcReq interface{}) (interface{}, error) {
+	req := grpcReq.(*pb.GetContentStatusRequest)
+	return models.IdRequest{ID: req.Id, Locale: req.Locale}, nil
+}
+
+func decodeGRPCDeleteRequest(_ context.Context, grpcReq interface{}) (interface{}, error) {
+	req := grpcReq.(*pb.IdRequest)
+	return models.DeleteRequest{ID: req.Id}, nil
+}
+
+func decodeGRPCUpdateRequest(_ context.Context, grpcReq interface{}) (interface{}, error) {
+	req := grpcReq.(*pb.UpdateContentStatusRequest)
+	update := models.UpdateRequest{
+		ID:          req.Id,
+		Name:        req.Name,
+		Color:       req.Color,
+		Description: req.Description,
+	}
+	if len(req.Translations) > 0 {
+		update.Translations = decodeGRPCTranslations(req.Translations)
+	}
+	if req.Order != nil {
+		order := int(*req.Order)
+		update.Order = &order
+	}
+	return update, nil
+}
+
+func decodeGRPCGetListRequest(_ context.Context, grpcReq interface{}) (interface{}, error) {
+	req := grpcReq.(*pb.ListContentStatusRequest)
+	return models.GetListRequest{
+		ReqPagination: models.ReqPagination{
+			Limit: int(req.Limit), Offset: int(req.Offset),
+		},
+		ContentStatusFilter: models.ContentStatusFilter{
+			Name: req.Name,
+		},
+		Locale: req.Locale,
+	}, nil
+}
+
+func decodeGRPCTranslations(pbTranslations []*pb.Translation) []contentModel.Translation {
+	translations := make([]contentModel.Translation, 0, len(pbTranslations))
+	for _, translation := range pbTranslations {
+		translations = append(translations, contentModel.Translation{
+			ID:     translation.GetId(),
+			Locale: translation.GetLocale(),
+			Name:   translation.GetName(),
+		})
+	}
+	return translations
+}
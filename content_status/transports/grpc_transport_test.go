@@ -0,0 +1,48 @@
+package transports
+
+import (
+	"context"
+	contentModel "github.com/SpawNKZ/content_service/content/models"
+	"github.com/SpawNKZ/content_service/content_status/models"
+	"github.com/SpawNKZ/content_service/pb"
+	"google.golang.org/protobuf/proto"
+	"reflect"
+	"testing"
+)
+
+func TestDecodeGRPCUpdateRequest(t *testing.T) {
+	order, color, description := 3, "#1e90ff", "Waiting for a reviewer."
+	got, err := decodeGRPCUpdateRequest(context.Background(), &pb.UpdateContentStatusRequest{
+		Id:           "64b000000000000000000001",
+		Name:         "review",
+		Translations: []*pb.Translation{{Locale: "ru", Name: "на проверке"}},
+		Order:        proto.Int32(3),
+		Color:        &color,
+		Description:  &description,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := models.UpdateRequest{
+		ID:           "64b000000000000000000001",
+		Name:         "review",
+		Translations: []contentModel.Translation{{Locale: "ru", Name: "на проверке"}},
+		Order:        &order,
+		Color:        &color,
+		Description:  &description,
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("decoded %+v, want %+v", got, want)
+	}
+
+	// A rename leaves the other fields out, they must stay as they are.
+	got, err = decodeGRPCUpdateRequest(context.Background(), &pb.UpdateContentStatusRequest{Id: "64b000000000000000000001", Name: "in review"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want = models.UpdateRequest{ID: "64b000000000000000000001", Name: "in review"}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("decoded %+v, want %+v", got, want)
+	}
+}
@@ -2,91 +2,68 @@ package transports
 
 import (
 	"context"
-	"encoding/json"
-	"github.com/SpawNKZ/content_service/common/errors"
+	"github.com/SpawNKZ/content_service/common/auth"
+	"github.com/SpawNKZ/content_service/common/transport"
+	"github.com/SpawNKZ/content_service/common/validation"
 	"github.com/SpawNKZ/content_service/content_status/endpoints"
 	"github.com/SpawNKZ/content_service/content_status/models"
 	contentStatusService "github.com/SpawNKZ/content_service/content_status/service"
-	"github.com/go-kit/kit/auth/jwt"
 	"github.com/go-kit/kit/endpoint"
-	"github.com/go-kit/kit/transport"
 	httpTransport "github.com/go-kit/kit/transport/http"
 	"github.com/go-kit/log"
 	"github.com/gorilla/mux"
 	"net/http"
-	"strconv"
 )
 
-type OperationResult struct {
-	Success bool        `json:"success"`
-	Message string      `json:"message"`
-	Data    interface{} `json:"data"`
-}
-
-func (o *OperationResult) Succeed(data interface{}) *OperationResult {
-	o.Success = true
-	o.Message = ""
-	o.Data = data
-	return o
-}
-
-func (o *OperationResult) Error(errorMessage string) *OperationResult {
-	o.Success = false
-	o.Message = errorMessage
-	o.Data = nil
-	return o
-}
+var requiredGroups = []string{"admin"}
 
-var (
-	opResult       = &OperationResult{}
-	requiredGroups = []string{"admin"}
-)
-
-// TODO: add auth middleware
-func MakeHTTPHandler(s contentStatusService.Service, logger log.Logger) http.Handler {
+// MakeHTTPHandler serves the content statuses to everyone, only admins may
+// create, update or delete them.
+func MakeHTTPHandler(s contentStatusService.Service, authMiddleware endpoint.Middleware, logger log.Logger) http.Handler {
 	r := mux.NewRouter()
-	options := []httpTransport.ServerOption{
-		httpTransport.ServerErrorHandler(transport.NewLogErrorHandler(logger)),
-		httpTransport.ServerErrorEncoder(errorEncoder),
-		httpTransport.ServerBefore(httpTransport.PopulateRequestContext, jwt.HTTPToContext()),
-	}
-	// GET     /programs                           retrieve programs list with all translations
-	// GET     /programs/search?q=asdsadas        retrieve programs by search terms
-	// GET     /programs/:id                       retrieve program by id
-	// POST    /programs                         	create program
-	// PUT     /programs/:id                       update the program
-	// DELETE  /programs/:id                       remove the given program
+	options := transport.ServerOptions(logger)
+	validate := validation.Middleware()
+	requireGroups := auth.RequireGroups(requiredGroups...)
+	// GET     /content_status                     retrieve content statuses in display order,
+	//                                             localized with the locale query param if any,
+	//                                             only the one named by the name query param if any
+	// GET     /content_status/:id                 retrieve content status by id, localized with
+	//                                             the locale query param if any
+	// POST    /content_status                     create content status
+	// PUT     /content_status/:id                 update the content status
+	// DELETE  /content_status/:id                 remove the given content status, moving its content
+	//                                             to the migrate_to status if any
 
 	sr := r.PathPrefix("/api/v1/content_status").Subrouter()
 
 	sr.Methods("POST").Path("").Handler(httpTransport.NewServer(
-		endpoints.MakeCreateEndpoint(s),
+		authMiddleware(requireGroups(validate(endpoints.MakeCreateEndpoint(s)))),
 		decodeCreateRequest,
-		encodeResponse,
+		transport.EncodeResponse,
 		options...,
 	))
 	sr.Methods("GET").Path("/{id}").Handler(httpTransport.NewServer(
-		endpoints.MakeGetOneEndpoint(s),
+		authMiddleware(validate(endpoints.MakeGetOneEndpoint(s))),
 		decodeGetOneRequest,
-		encodeResponse,
+		transport.EncodeResponse,
 		options...,
 	))
 	sr.Methods("PUT").Path("/{id}").Handler(httpTransport.NewServer(
-		endpoints.MakeUpdateEndpoint(s),
+		authMiddleware(requireGroups(validate(endpoints.MakeUpdateEndpoint(s)))),
 		decodeUpdateRequest,
-		encodeResponse,
+		transport.EncodeResponse,
 		options...,
 	))
 	sr.Methods("DELETE").Path("/{id}").Handler(httpTransport.NewServer(
-		endpoints.MakeDeleteOneEndpoint(s),
+		authMiddleware(requireGroups(endpoints.MakeDeleteOneEndpoint(s))),
 		decodeDeleteOneRequest,
-		encodeResponse,
+		transport.EncodeResponse,
 		options...,
 	))
 	sr.Methods("GET").Path("").Handler(httpTransport.NewServer(
-		endpoints.MakeGetListEndpoint(s),
+		authMiddleware(validate(endpoints.MakeGetListEndpoint(s))),
 		decodeGetListRequest,
-		encodeResponse,
+		transport.EncodeResponse,
 		options...,
 	))
 	return r
@@ -94,29 +71,27 @@ func MakeHTTPHandler(s contentStatusService.Service, logger log.Logger) http.Han
 
 func decodeCreateRequest(_ context.Context, r *http.Request) (request interface{}, err error) {
 	var req models.CreateRequest
-	if e := json.NewDecoder(r.Body).Decode(&req); e != nil {
-		return nil, e
+	if err := transport.DecodeJSON(r, &req); err != nil {
+		return nil, err
 	}
 	return req, nil
 }
 
 func decodeGetOneRequest(_ context.Context, r *http.Request) (request interface{}, err error) {
-	vars := mux.Vars(r)
-	id, ok := vars["id"]
-	if !ok {
-		return nil, errors.ErrBadRouting
+	id, err := transport.PathVar(r, "id")
+	if err != nil {
+		return nil, err
 	}
-	return models.IdRequest{ID: id}, nil
+	return models.IdRequest{ID: id, Locale: r.URL.Query().Get("locale")}, nil
 }
 
 func decodeUpdateRequest(_ context.Context, r *http.Request) (request interface{}, err error) {
-	vars := mux.Vars(r)
-	id, ok := vars["id"]
-	if !ok {
-		return nil, errors.ErrBadRouting
+	id, err := transport.PathVar(r, "id")
+	if err != nil {
+		return nil, err
 	}
 	var contentStatusUpdate models.UpdateRequest
-	if err := json.NewDecoder(r.Body).Decode(&contentStatusUpdate); err != nil {
+	if err := transport.DecodeJSON(r, &contentStatusUpdate); err != nil {
 		return nil, err
 	}
 
@@ -125,41 +100,28 @@ func decodeUpdateRequest(_ context.Context, r *http.Request) (request interface{
 	return contentStatusUpdate, nil
 }
 
-func encodeResponse(ctx context.Context, w http.ResponseWriter, response interface{}) error {
-	if e, ok := response.(endpoint.Failer); ok && e.Failed() != nil {
-		// Not a Go kit transports error, but a business-logic error.
-		// Provide those as HTTP errors.
-		errorEncoder(ctx, e.Failed(), w)
-		return nil
-	}
-	w.Header().Set("Content-Type", "application/json; charset=utf-8")
-	return json.NewEncoder(w).Encode(opResult.Succeed(response))
-}
-
 func decodeDeleteOneRequest(_ context.Context, r *http.Request) (request interface{}, err error) {
-	vars := mux.Vars(r)
-	id, ok := vars["id"]
-	if !ok {
-		return nil, errors.ErrBadRouting
+	id, err := transport.PathVar(r, "id")
+	if err != nil {
+		return nil, err
 	}
 
-	return models.IdRequest{ID: id}, nil
+	return models.DeleteRequest{ID: id, MigrateTo: r.URL.Query().Get("migrate_to")}, nil
 }
 
 func decodeGetListRequest(_ context.Context, r *http.Request) (request interface{}, err error) {
-	limitStr := r.URL.Query().Get("limit")
-	offsetStr := r.URL.Query().Get("offset")
-	limit, _ := strconv.Atoi(limitStr)
-	offset, _ := strconv.Atoi(offsetStr)
+	limit, offset, err := transport.Pagination(r)
+	if err != nil {
+		return nil, err
+	}
 
 	return models.GetListRequest{
 		ReqPagination: models.ReqPagination{
 			Limit: limit, Offset: offset,
 		},
+		ContentStatusFilter: models.ContentStatusFilter{
+			Name: r.URL.Query().Get("name"),
+		},
+		Locale: r.URL.Query().Get("locale"),
 	}, nil
 }
-
-func errorEncoder(_ context.Context, err error, w http.ResponseWriter) {
-	w.WriteHeader(errors.ErrorToHttpCode(err))
-	json.NewEncoder(w).Encode(opResult.Error(err.Error()))
-}
@@ -0,0 +1,70 @@
+package transports
+
+import (
+	"context"
+	"github.com/SpawNKZ/content_service/common/auth"
+	"github.com/SpawNKZ/content_service/content_status/models"
+	contentStatusService "github.com/SpawNKZ/content_service/content_status/service"
+	"github.com/go-kit/log"
+	"github.com/golang-jwt/jwt/v4"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+const testSecret = "test-secret"
+
+// fakeService records who deleted a status.
+type fakeService struct {
+	contentStatusService.Service
+	deletedBy []string
+}
+
+func (s *fakeService) Delete(ctx context.Context, _ models.DeleteRequest) error {
+	identity, _ := auth.FromContext(ctx)
+	s.deletedBy = append(s.deletedBy, identity.UserId)
+	return nil
+}
+
+func TestDeleteRequiresAdmin(t *testing.T) {
+	tests := []struct {
+		name  string
+		token string
+		want  int
+	}{
+		{"anonymous", "", http.StatusUnauthorized},
+		{"author", sign(t, "alice", auth.GroupAuthor), http.StatusForbidden},
+		{"admin", sign(t, "root", auth.GroupAdmin), http.StatusOK},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := &fakeService{}
+			h := MakeHTTPHandler(s, auth.NewParser(testSecret), log.NewNopLogger())
+
+			req := httptest.NewRequest("DELETE", "/api/v1/content_status/64b000000000000000000001?migrate_to=64b000000000000000000002", nil)
+			if tt.token != "" {
+				req.Header.Set("Authorization", "Bearer "+tt.token)
+			}
+			rec := httptest.NewRecorder()
+			h.ServeHTTP(rec, req)
+
+			if rec.Code != tt.want {
+				t.Fatalf("status = %d, want %d: %s", rec.Code, tt.want, rec.Body)
+			}
+			if tt.want == http.StatusOK && (len(s.deletedBy) != 1 || s.deletedBy[0] != "root") {
+				t.Fatalf("deleted by %q, want the admin, whom the moved content history names", s.deletedBy)
+			}
+			if tt.want != http.StatusOK && len(s.deletedBy) != 0 {
+				t.Fatal("the request reached the service")
+			}
+		})
+	}
+}
+
+func sign(t *testing.T, userId string, groups ...string) string {
+	token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{"sub": userId, "groups": groups}).SignedString([]byte(testSecret))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return token
+}
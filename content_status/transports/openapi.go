@@ -0,0 +1,25 @@
+package transports
+
+import (
+	"github.com/SpawNKZ/content_service/common/openapi"
+	"github.com/SpawNKZ/content_service/content_status/models"
+)
+
+// OpenAPIOperations describes the routes registered by MakeHTTPHandler.
+func OpenAPIOperations() []openapi.Operation {
+	const tag = "content_status"
+	id := openapi.PathParam("id")
+	locale := openapi.QueryParam("locale", "string", "Locale to return the translation and label in.")
+	return []openapi.Operation{
+		{Method: "POST", Path: "/api/v1/content_status", Tag: tag, Summary: "Create a content status", Request: models.CreateRequest{}, Response: models.CreateResponse{}, Secured: true},
+		{Method: "GET", Path: "/api/v1/content_status/{id}", Tag: tag, Summary: "Get a content status by ID", Params: []openapi.Param{id, locale}, Response: models.GetOneResponse{}},
+		{Method: "PUT", Path: "/api/v1/content_status/{id}", Tag: tag, Summary: "Update a content status", Params: []openapi.Param{id}, Request: models.UpdateRequest{}, Response: models.UpdateResponse{}, Secured: true},
+		{Method: "DELETE", Path: "/api/v1/content_status/{id}", Tag: tag, Summary: "Delete a content status",
+			Params: []openapi.Param{
+				id,
+				openapi.QueryParam("migrate_to", "string", "Status the content using the deleted one is moved to. Without it, deleting a status in use fails."),
+			},
+			Response: models.DeleteOneResponse{}, Secured: true},
+		{Method: "GET", Path: "/api/v1/content_status", Tag: tag, Summary: "List content statuses in display order", Params: append(openapi.PaginationParams(), locale, openapi.QueryParam("name", "string", "Lists only the status with this name.")), Response: models.GetListResponse{}},
+	}
+}
@@ -7,8 +7,9 @@ import (
 	"go.mongodb.org/mongo-driver/mongo/options"
 )
 
-func NewDBConnection(ctx context.Context, dbSource string, logger log.Logger) (*mongo.Client, error) {
-	client, err := mongo.Connect(ctx, options.Client().ApplyURI(dbSource))
+func NewDBConnection(ctx context.Context, dbSource string, logger log.Logger, opts ...*options.ClientOptions) (*mongo.Client, error) {
+	opts = append([]*options.ClientOptions{options.Client().ApplyURI(dbSource)}, opts...)
+	client, err := mongo.Connect(ctx, opts...)
 	if err != nil {
 		logger.Log("cannot connect to DB", err)
 		return nil, err
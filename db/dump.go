@@ -0,0 +1,95 @@
+package db
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"io"
+)
+
+// maxDocumentSize is the size of the largest BSON document Mongo stores,
+// its extended JSON form fits in a few times that.
+const maxDocumentSize = 16 << 20
+
+// dumpLine is a line of an export: a document in canonical extended JSON, so
+// that ObjectIDs and dates survive the round trip, and its collection.
+type dumpLine struct {
+	Collection string          `json:"collection"`
+	Document   json.RawMessage `json:"document"`
+}
+
+// Export writes every document of the collections to w, one JSON line each.
+func Export(ctx context.Context, client *mongo.Client, w io.Writer, collections []string) (int, error) {
+	database := client.Database(Database)
+	enc := json.NewEncoder(w)
+	count := 0
+	for _, collection := range collections {
+		cursor, err := database.Collection(collection).Find(ctx, bson.M{})
+		if err != nil {
+			return count, err
+		}
+		for cursor.Next(ctx) {
+			document, err := bson.MarshalExtJSON(cursor.Current, true, false)
+			if err != nil {
+				cursor.Close(ctx)
+				return count, err
+			}
+			if err := enc.Encode(dumpLine{Collection: collection, Document: document}); err != nil {
+				cursor.Close(ctx)
+				return count, err
+			}
+			count++
+		}
+		err = cursor.Err()
+		cursor.Close(ctx)
+		if err != nil {
+			return count, err
+		}
+	}
+	return count, nil
+}
+
+// Import writes the documents of an export, replacing the ones with the same
+// ID, so that importing the same export twice changes nothing.
+func Import(ctx context.Context, client *mongo.Client, r io.Reader) (int, error) {
+	database := client.Database(Database)
+	known := map[string]bool{}
+	for _, collection := range Collections {
+		known[collection] = true
+	}
+
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), 4*maxDocumentSize)
+	count := 0
+	for line := 1; scanner.Scan(); line++ {
+		if len(scanner.Bytes()) == 0 {
+			continue
+		}
+		var l dumpLine
+		if err := json.Unmarshal(scanner.Bytes(), &l); err != nil {
+			return count, fmt.Errorf("line %d: %w", line, err)
+		}
+		if !known[l.Collection] {
+			return count, fmt.Errorf("line %d: unknown collection %q", line, l.Collection)
+		}
+		var document bson.D
+		if err := bson.UnmarshalExtJSON(l.Document, true, &document); err != nil {
+			return count, fmt.Errorf("line %d: %w", line, err)
+		}
+		id, ok := document.Map()["_id"]
+		if !ok {
+			return count, fmt.Errorf("line %d: document without _id", line)
+		}
+
+		_, err := database.Collection(l.Collection).ReplaceOne(ctx, bson.M{"_id": id}, document, options.Replace().SetUpsert(true))
+		if err != nil {
+			return count, fmt.Errorf("line %d: %w", line, err)
+		}
+		count++
+	}
+	return count, scanner.Err()
+}
@@ -0,0 +1,86 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// Database holds the collections of every resource.
+const Database = "core"
+
+// Collections are the collections of the service, in the order they are
+// exported and imported: referenced documents come first.
+var Collections = []string{"content_status", "post_category", "content", "post", "review", "content_history"}
+
+// Index is a secondary index of a collection.
+type Index struct {
+	Collection string
+	Keys       bson.D
+	Unique     bool
+}
+
+// Indexes backs the filters and sorts of the repositories.
+var Indexes = []Index{
+	{Collection: "content_status", Keys: bson.D{{Key: "name", Value: 1}}, Unique: true},
+	{Collection: "post_category", Keys: bson.D{{Key: "name", Value: 1}}, Unique: true},
+	{Collection: "content", Keys: bson.D{{Key: "deleted_at", Value: 1}, {Key: "status_id", Value: 1}}},
+	{Collection: "content", Keys: bson.D{{Key: "author_id", Value: 1}}},
+	{Collection: "content", Keys: bson.D{{Key: "contributors.user_id", Value: 1}}},
+	{Collection: "content", Keys: bson.D{{Key: "subject_id", Value: 1}, {Key: "microtopic_id", Value: 1}}},
+	{Collection: "post", Keys: bson.D{{Key: "content_id", Value: 1}, {Key: "deleted_at", Value: 1}, {Key: "position", Value: 1}}},
+	{Collection: "post", Keys: bson.D{{Key: "category_id", Value: 1}}},
+	{Collection: "review", Keys: bson.D{{Key: "content_id", Value: 1}, {Key: "created_at", Value: -1}}},
+	{Collection: "review", Keys: bson.D{{Key: "reviewer_id", Value: 1}, {Key: "state", Value: 1}}},
+	{Collection: "content_history", Keys: bson.D{{Key: "content_id", Value: 1}, {Key: "created_at", Value: -1}}},
+}
+
+// EnsureIndexes builds the indexes of Indexes that do not exist yet.
+func EnsureIndexes(ctx context.Context, client *mongo.Client) error {
+	database := client.Database(Database)
+	for collection, models := range indexModels() {
+		if _, err := database.Collection(collection).Indexes().CreateMany(ctx, models); err != nil {
+			return fmt.Errorf("%s: %w", collection, err)
+		}
+	}
+	return nil
+}
+
+// Reindex drops the secondary indexes of every collection and builds the
+// ones of Indexes.
+func Reindex(ctx context.Context, client *mongo.Client) error {
+	database := client.Database(Database)
+	models := indexModels()
+	for _, collection := range Collections {
+		indexes := database.Collection(collection).Indexes()
+		if _, err := indexes.DropAll(ctx); err != nil && !isNamespaceNotFound(err) {
+			return fmt.Errorf("%s: %w", collection, err)
+		}
+		if len(models[collection]) == 0 {
+			continue
+		}
+		if _, err := indexes.CreateMany(ctx, models[collection]); err != nil {
+			return fmt.Errorf("%s: %w", collection, err)
+		}
+	}
+	return nil
+}
+
+func indexModels() map[string][]mongo.IndexModel {
+	models := map[string][]mongo.IndexModel{}
+	for _, index := range Indexes {
+		models[index.Collection] = append(models[index.Collection], mongo.IndexModel{
+			Keys:    index.Keys,
+			Options: options.Index().SetUnique(index.Unique),
+		})
+	}
+	return models
+}
+
+func isNamespaceNotFound(err error) bool {
+	var cmdErr mongo.CommandError
+	return errors.As(err, &cmdErr) && cmdErr.Name == "NamespaceNotFound"
+}
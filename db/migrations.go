@@ -0,0 +1,244 @@
+package db
+
+import (
+	"context"
+	"github.com/go-kit/log"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"time"
+)
+
+const migrationsCollection = "migrations"
+
+// Migration brings the documents written by an older version of the service
+// to the current schema. Up must be safe to run again after a failure.
+type Migration struct {
+	Version int
+	Name    string
+	Up      func(ctx context.Context, db *mongo.Database) error
+}
+
+// Migrations are applied in order, each one once. Append new ones, never
+// change or reorder the applied ones.
+var Migrations = []Migration{
+	{Version: 1, Name: "post_timestamps", Up: backfillTimestamps("post")},
+	{Version: 2, Name: "content_timestamps", Up: backfillTimestamps("content")},
+	{Version: 3, Name: "post_positions", Up: backfillPostPositions},
+	{Version: 4, Name: "content_status_ids", Up: contentStatusIds},
+	{Version: 5, Name: "content_author_contributors", Up: authorContributors},
+	{Version: 6, Name: "post_category_ids", Up: postCategoryIds},
+	{Version: 7, Name: "content_post_counts", Up: contentPostCounts},
+}
+
+type migrationRecord struct {
+	Version   int       `bson:"_id"`
+	Name      string    `bson:"name"`
+	AppliedAt time.Time `bson:"applied_at"`
+}
+
+// Migrate applies the migrations that were not applied yet and records them.
+// It runs when the service starts and from the migrate command.
+func Migrate(ctx context.Context, client *mongo.Client, logger log.Logger) error {
+	database := client.Database(Database)
+	records := database.Collection(migrationsCollection)
+
+	cursor, err := records.Find(ctx, bson.M{})
+	if err != nil {
+		return err
+	}
+	var applied []migrationRecord
+	if err := cursor.All(ctx, &applied); err != nil {
+		return err
+	}
+	done := map[int]bool{}
+	for _, record := range applied {
+		done[record.Version] = true
+	}
+
+	for _, m := range Migrations {
+		if done[m.Version] {
+			continue
+		}
+		logger.Log("migration", m.Name, "version", m.Version, "msg", "applying")
+		if err := m.Up(ctx, database); err != nil {
+			logger.Log("migration", m.Name, "version", m.Version, "err", err)
+			return err
+		}
+		// Instances starting together may apply the same migration, the
+		// first one records it.
+		_, err := records.InsertOne(ctx, migrationRecord{Version: m.Version, Name: m.Name, AppliedAt: time.Now()})
+		if err != nil && !mongo.IsDuplicateKeyError(err) {
+			return err
+		}
+	}
+	return nil
+}
+
+// backfillTimestamps dates the documents written before the timestamps were
+// tracked with the creation time of their ObjectID.
+func backfillTimestamps(collection string) func(ctx context.Context, db *mongo.Database) error {
+	return func(ctx context.Context, db *mongo.Database) error {
+		created := bson.D{{Key: "$toDate", Value: "$_id"}}
+		_, err := db.Collection(collection).UpdateMany(ctx,
+			bson.M{"created_at": bson.M{"$exists": false}},
+			mongo.Pipeline{{{Key: "$set", Value: bson.D{
+				{Key: "created_at", Value: created},
+				{Key: "updated_at", Value: created},
+			}}}},
+		)
+		return err
+	}
+}
+
+// backfillPostPositions numbers the posts of every content having posts
+// without a position, keeping the existing order and then the creation order.
+func backfillPostPositions(ctx context.Context, db *mongo.Database) error {
+	posts := db.Collection("post")
+	contentIds, err := posts.Distinct(ctx, "content_id", bson.M{"position": bson.M{"$exists": false}})
+	if err != nil {
+		return err
+	}
+
+	for _, contentId := range contentIds {
+		opts := options.Find().
+			SetSort(bson.D{{Key: "position", Value: 1}, {Key: "_id", Value: 1}}).
+			SetProjection(bson.M{"_id": 1})
+		cursor, err := posts.Find(ctx, bson.M{"content_id": contentId, "deleted_at": nil}, opts)
+		if err != nil {
+			return err
+		}
+		var ids []struct {
+			ID interface{} `bson:"_id"`
+		}
+		if err := cursor.All(ctx, &ids); err != nil {
+			return err
+		}
+
+		for position, id := range ids {
+			if _, err := posts.UpdateByID(ctx, id.ID, bson.M{"$set": bson.M{"position": position}}); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// contentStatusIds replaces the status names older content stored in
+// status_id with the ID of the status.
+func contentStatusIds(ctx context.Context, db *mongo.Database) error {
+	cursor, err := db.Collection("content_status").Find(ctx, bson.M{}, options.Find().SetProjection(bson.M{"_id": 1, "name": 1}))
+	if err != nil {
+		return err
+	}
+	var statuses []struct {
+		ID   primitive.ObjectID `bson:"_id"`
+		Name string             `bson:"name"`
+	}
+	if err := cursor.All(ctx, &statuses); err != nil {
+		return err
+	}
+
+	content := db.Collection("content")
+	for _, status := range statuses {
+		_, err := content.UpdateMany(ctx,
+			bson.M{"status_id": status.Name},
+			bson.M{"$set": bson.M{"status_id": status.ID.Hex()}},
+		)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// authorContributors adds the author to the contributors of the content
+// written before they existed. Content already listing its author, and its
+// co-authors, is left as is.
+func authorContributors(ctx context.Context, db *mongo.Database) error {
+	_, err := db.Collection("content").UpdateMany(ctx,
+		bson.M{
+			"author_id": bson.M{"$nin": bson.A{nil, ""}},
+			"$expr": bson.M{"$not": bson.A{bson.M{"$anyElementTrue": bson.A{bson.M{"$map": bson.M{
+				"input": bson.M{"$ifNull": bson.A{"$contributors", bson.A{}}},
+				"as":    "contributor",
+				"in": bson.M{"$and": bson.A{
+					bson.M{"$eq": bson.A{"$$contributor.user_id", "$author_id"}},
+					bson.M{"$eq": bson.A{"$$contributor.role", "author"}},
+				}},
+			}}}}}},
+		},
+		bson.A{bson.M{"$set": bson.M{"contributors": bson.M{"$concatArrays": bson.A{
+			bson.M{"$ifNull": bson.A{"$contributors", bson.A{}}},
+			bson.A{bson.M{"user_id": "$author_id", "role": "author"}},
+		}}}}},
+	)
+	return err
+}
+
+// postCategoryIds replaces the category names posts stored in category with
+// the ID of the category, in category_id.
+func postCategoryIds(ctx context.Context, db *mongo.Database) error {
+	cursor, err := db.Collection("post_category").Find(ctx, bson.M{}, options.Find().SetProjection(bson.M{"_id": 1, "name": 1}))
+	if err != nil {
+		return err
+	}
+	var categories []struct {
+		ID   primitive.ObjectID `bson:"_id"`
+		Name string             `bson:"name"`
+	}
+	if err := cursor.All(ctx, &categories); err != nil {
+		return err
+	}
+
+	posts := db.Collection("post")
+	for _, category := range categories {
+		_, err := posts.UpdateMany(ctx,
+			bson.M{"category": category.Name},
+			bson.M{
+				"$set":   bson.M{"category_id": category.ID.Hex()},
+				"$unset": bson.M{"category": ""},
+			},
+		)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// contentPostCounts counts the posts of the content written before the
+// inserts and deletes of posts kept post_count.
+func contentPostCounts(ctx context.Context, db *mongo.Database) error {
+	cursor, err := db.Collection("post").Aggregate(ctx, mongo.Pipeline{
+		{{Key: "$match", Value: bson.M{"deleted_at": nil}}},
+		{{Key: "$group", Value: bson.M{"_id": "$content_id", "count": bson.M{"$sum": 1}}}},
+	})
+	if err != nil {
+		return err
+	}
+	var counts []struct {
+		ContentId string `bson:"_id"`
+		Count     int    `bson:"count"`
+	}
+	if err := cursor.All(ctx, &counts); err != nil {
+		return err
+	}
+
+	content := db.Collection("content")
+	if _, err := content.UpdateMany(ctx, bson.M{}, bson.M{"$set": bson.M{"post_count": 0}}); err != nil {
+		return err
+	}
+	for _, count := range counts {
+		objectId, err := primitive.ObjectIDFromHex(count.ContentId)
+		if err != nil {
+			// No content has this ID, the posts are orphans.
+			continue
+		}
+		if _, err := content.UpdateByID(ctx, objectId, bson.M{"$set": bson.M{"post_count": count.Count}}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,49 @@
+package db
+
+import (
+	"context"
+	"fmt"
+	"github.com/go-kit/kit/metrics"
+	"go.mongodb.org/mongo-driver/event"
+)
+
+// NewCommandMonitor records the duration of every Mongo command,
+// labelled by command name and whether it failed.
+func NewCommandMonitor(latency metrics.Histogram) *event.CommandMonitor {
+	return &event.CommandMonitor{
+		Succeeded: func(_ context.Context, e *event.CommandSucceededEvent) {
+			latency.With("command", e.CommandName, "error", fmt.Sprint(false)).Observe(e.Duration.Seconds())
+		},
+		Failed: func(_ context.Context, e *event.CommandFailedEvent) {
+			latency.With("command", e.CommandName, "error", fmt.Sprint(true)).Observe(e.Duration.Seconds())
+		},
+	}
+}
+
+// ComposeMonitors fans the command events out to every monitor,
+// since the Mongo client accepts a single one.
+func ComposeMonitors(monitors ...*event.CommandMonitor) *event.CommandMonitor {
+	return &event.CommandMonitor{
+		Started: func(ctx context.Context, e *event.CommandStartedEvent) {
+			for _, m := range monitors {
+				if m.Started != nil {
+					m.Started(ctx, e)
+				}
+			}
+		},
+		Succeeded: func(ctx context.Context, e *event.CommandSucceededEvent) {
+			for _, m := range monitors {
+				if m.Succeeded != nil {
+					m.Succeeded(ctx, e)
+				}
+			}
+		},
+		Failed: func(ctx context.Context, e *event.CommandFailedEvent) {
+			for _, m := range monitors {
+				if m.Failed != nil {
+					m.Failed(ctx, e)
+				}
+			}
+		},
+	}
+}
@@ -0,0 +1,54 @@
+package db
+
+import (
+	"context"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"time"
+)
+
+// PurgeDeleted removes for good the content and posts deleted before the given
+// time, with the posts of the removed content, and reports how many documents
+// were removed per collection.
+func PurgeDeleted(ctx context.Context, client *mongo.Client, before time.Time) (map[string]int64, error) {
+	database := client.Database(Database)
+	deleted := bson.M{"deleted_at": bson.M{"$ne": nil, "$lt": before}}
+	purged := map[string]int64{}
+
+	cursor, err := database.Collection("content").Find(ctx, deleted, options.Find().SetProjection(bson.M{"_id": 1}))
+	if err != nil {
+		return purged, err
+	}
+	var content []struct {
+		ID primitive.ObjectID `bson:"_id"`
+	}
+	if err := cursor.All(ctx, &content); err != nil {
+		return purged, err
+	}
+	contentIds := make([]primitive.ObjectID, 0, len(content))
+	hexIds := make([]string, 0, len(content))
+	for _, contentObj := range content {
+		contentIds = append(contentIds, contentObj.ID)
+		hexIds = append(hexIds, contentObj.ID.Hex())
+	}
+
+	// The posts go first: a purge stopped in between finds the content, and
+	// its posts, again on the next run.
+	result, err := database.Collection("post").DeleteMany(ctx, bson.M{"$or": bson.A{
+		deleted,
+		bson.M{"content_id": bson.M{"$in": hexIds}},
+	}})
+	if err != nil {
+		return purged, err
+	}
+	purged["post"] = result.DeletedCount
+
+	result, err = database.Collection("content").DeleteMany(ctx, bson.M{"_id": bson.M{"$in": contentIds}})
+	if err != nil {
+		return purged, err
+	}
+	purged["content"] = result.DeletedCount
+	return purged, nil
+}
@@ -0,0 +1,47 @@
+package db
+
+import (
+	"context"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo/integration/mtest"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestPurgeDeletedRemovesThePostsOfPurgedContent(t *testing.T) {
+	mt := mtest.New(t, mtest.NewOptions().ClientType(mtest.Mock))
+	defer mt.Close()
+
+	mt.Run("purge", func(mt *mtest.T) {
+		contentId := primitive.NewObjectID()
+		mt.AddMockResponses(
+			mtest.CreateCursorResponse(0, Database+".content", mtest.FirstBatch, bson.D{{Key: "_id", Value: contentId}}),
+			bson.D{{Key: "ok", Value: 1}, {Key: "n", Value: 3}},
+			bson.D{{Key: "ok", Value: 1}, {Key: "n", Value: 1}},
+		)
+
+		purged, err := PurgeDeleted(context.Background(), mt.Client, time.Now())
+		if err != nil {
+			mt.Fatal(err)
+		}
+		if want := map[string]int64{"post": 3, "content": 1}; !reflect.DeepEqual(purged, want) {
+			mt.Fatalf("purged %v, want %v", purged, want)
+		}
+
+		mt.GetStartedEvent() // the lookup of the content to purge
+		posts := mt.GetStartedEvent()
+		if posts.CommandName != "delete" || posts.Command.Lookup("delete").StringValue() != "post" {
+			mt.Fatalf("second command = %s, want the delete of the posts", posts.Command)
+		}
+		postIds, err := posts.Command.LookupErr("deletes", "0", "q", "$or", "1", "content_id", "$in", "0")
+		if err != nil || postIds.StringValue() != contentId.Hex() {
+			mt.Fatalf("posts deleted with %s, want the posts of %s", posts.Command, contentId.Hex())
+		}
+		content := mt.GetStartedEvent()
+		if content.CommandName != "delete" || content.Command.Lookup("delete").StringValue() != "content" {
+			mt.Fatalf("third command = %s, want the delete of the content", content.Command)
+		}
+	})
+}
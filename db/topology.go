@@ -0,0 +1,28 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+// ErrNoTransactions is returned by RequireTransactions for standalone servers.
+var ErrNoTransactions = errors.New("MongoDB is a standalone server, transactions need a replica set or a sharded cluster")
+
+// RequireTransactions checks that the deployment supports the transactions
+// the post repository runs, a replica set or a sharded cluster.
+func RequireTransactions(ctx context.Context, client *mongo.Client) error {
+	var hello struct {
+		SetName string `bson:"setName"`
+		Msg     string `bson:"msg"`
+	}
+	err := client.Database("admin").RunCommand(ctx, bson.D{{Key: "hello", Value: 1}}).Decode(&hello)
+	if err != nil {
+		return err
+	}
+	if hello.SetName == "" && hello.Msg != "isdbgrid" {
+		return ErrNoTransactions
+	}
+	return nil
+}
@@ -1,16 +1,85 @@
 package mb
 
 import (
+	"context"
 	"github.com/go-kit/log"
 	"github.com/nats-io/nats.go"
+	"time"
 )
 
-func NewNatsConnection(connString string, logger log.Logger) (*nats.Conn, func(), error) {
-	nc, err := nats.Connect(connString)
+// ReconnectPolicy controls how the client behaves when the server goes away.
+type ReconnectPolicy struct {
+	// MaxReconnects is the number of reconnect attempts, negative for unlimited.
+	MaxReconnects int
+	// ReconnectWait is the pause between attempts to the same server.
+	ReconnectWait time.Duration
+}
+
+// NewNatsConnection connects to NATS and returns a function that drains the
+// connection: subscriptions stop receiving, pending messages are flushed and
+// the connection is closed.
+//
+// A server that is down at startup does not fail the connection, the client
+// keeps reconnecting in the background according to the policy and logs
+// every change of the connection state.
+func NewNatsConnection(connString string, policy ReconnectPolicy, logger log.Logger) (*nats.Conn, func(context.Context) error, error) {
+	nc, err := nats.Connect(connString,
+		nats.RetryOnFailedConnect(true),
+		nats.MaxReconnects(policy.MaxReconnects),
+		nats.ReconnectWait(policy.ReconnectWait),
+		nats.ConnectHandler(func(nc *nats.Conn) {
+			logger.Log("event", "connected", "url", nc.ConnectedUrl())
+		}),
+		nats.DisconnectErrHandler(func(_ *nats.Conn, err error) {
+			logger.Log("event", "disconnected", "err", err)
+		}),
+		nats.ReconnectHandler(func(nc *nats.Conn) {
+			logger.Log("event", "reconnected", "url", nc.ConnectedUrl())
+		}),
+		nats.ClosedHandler(func(nc *nats.Conn) {
+			logger.Log("event", "closed", "err", nc.LastError())
+		}),
+		nats.ErrorHandler(func(_ *nats.Conn, sub *nats.Subscription, err error) {
+			if sub != nil {
+				logger.Log("event", "error", "subject", sub.Subject, "err", err)
+				return
+			}
+			logger.Log("event", "error", "err", err)
+		}),
+	)
 	if err != nil {
 		logger.Log("cannot connect to NATS", err)
-		return nil, nil, err
+		return nil, func(context.Context) error { return nil }, err
+	}
+
+	return nc, func(ctx context.Context) error { return drain(ctx, nc) }, nil
+}
+
+func drain(ctx context.Context, nc *nats.Conn) error {
+	// Nothing can be flushed while the client is still reconnecting.
+	if !nc.IsConnected() {
+		nc.Close()
+		return nil
 	}
 
-	return nc, nc.Close, nil
+	if err := nc.FlushWithContext(ctx); err != nil && nc.IsConnected() {
+		return err
+	}
+
+	if err := nc.Drain(); err != nil {
+		nc.Close()
+		return err
+	}
+
+	ticker := time.NewTicker(10 * time.Millisecond)
+	defer ticker.Stop()
+	for !nc.IsClosed() {
+		select {
+		case <-ctx.Done():
+			nc.Close()
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+	return nil
 }
@@ -0,0 +1,94 @@
+package mb
+
+import (
+	"context"
+	"errors"
+	"github.com/go-kit/kit/circuitbreaker"
+	"github.com/go-kit/kit/endpoint"
+	"github.com/go-kit/log"
+	"github.com/nats-io/nats.go"
+	"github.com/sony/gobreaker"
+	"time"
+)
+
+// RequestPolicy describes how a request-reply call over NATS is guarded.
+type RequestPolicy struct {
+	// Timeout bounds a single attempt.
+	Timeout time.Duration
+	// Retries is the number of attempts made after the first one fails.
+	Retries int
+	// Backoff is the wait before the first retry, doubled on each next one.
+	Backoff time.Duration
+	// BreakerFailures is the number of consecutive failed calls that opens
+	// the circuit breaker.
+	BreakerFailures uint32
+	// BreakerTimeout is the time the breaker stays open before letting a
+	// trial call through.
+	BreakerTimeout time.Duration
+}
+
+// NewResilientMiddleware retries calls that failed with Unavailable errors
+// with exponential backoff and stops calling the remote side at all while
+// the circuit breaker is open, so that a dead dependency fails fast instead
+// of holding every request for the full timeout. Other errors, such as the
+// caller giving up or a malformed reply, are returned at once and do not
+// count toward the breaker.
+func NewResilientMiddleware(name string, policy RequestPolicy, logger log.Logger) endpoint.Middleware {
+	breaker := circuitbreaker.Gobreaker(gobreaker.NewCircuitBreaker(gobreaker.Settings{
+		Name:    name,
+		Timeout: policy.BreakerTimeout,
+		ReadyToTrip: func(counts gobreaker.Counts) bool {
+			return counts.ConsecutiveFailures >= policy.BreakerFailures
+		},
+		IsSuccessful: func(err error) bool {
+			return !Unavailable(err)
+		},
+		OnStateChange: func(name string, from gobreaker.State, to gobreaker.State) {
+			logger.Log("breaker", name, "from", from.String(), "to", to.String())
+		},
+	}))
+
+	return func(next endpoint.Endpoint) endpoint.Endpoint {
+		return breaker(retry(policy.Retries, policy.Backoff, next))
+	}
+}
+
+func retry(retries int, backoff time.Duration, next endpoint.Endpoint) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (response interface{}, err error) {
+		wait := backoff
+		for attempt := 0; ; attempt++ {
+			response, err = next(ctx, request)
+			if !Unavailable(err) || attempt >= retries {
+				return response, err
+			}
+
+			select {
+			case <-ctx.Done():
+				return nil, ctx.Err()
+			case <-time.After(wait):
+			}
+			wait *= 2
+		}
+	}
+}
+
+// Unavailable reports whether err means the remote side could not be reached
+// or did not answer in time, including while the circuit breaker is open.
+func Unavailable(err error) bool {
+	for _, target := range []error{
+		context.DeadlineExceeded,
+		nats.ErrTimeout,
+		nats.ErrNoResponders,
+		nats.ErrConnectionClosed,
+		nats.ErrConnectionDraining,
+		nats.ErrConnectionReconnecting,
+		nats.ErrDisconnected,
+		gobreaker.ErrOpenState,
+		gobreaker.ErrTooManyRequests,
+	} {
+		if errors.Is(err, target) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,65 @@
+package mb
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"github.com/go-kit/log"
+	"github.com/nats-io/nats.go"
+	"github.com/sony/gobreaker"
+	"testing"
+	"time"
+)
+
+var testPolicy = RequestPolicy{
+	Timeout:         time.Second,
+	Retries:         2,
+	Backoff:         time.Millisecond,
+	BreakerFailures: 2,
+	BreakerTimeout:  time.Minute,
+}
+
+func TestResilientMiddlewareRetriesUnavailable(t *testing.T) {
+	calls := 0
+	call := NewResilientMiddleware("test", testPolicy, log.NewNopLogger())(func(context.Context, interface{}) (interface{}, error) {
+		calls++
+		return nil, nats.ErrTimeout
+	})
+
+	for i := 0; i < 2; i++ {
+		if _, err := call(context.Background(), nil); !errors.Is(err, nats.ErrTimeout) {
+			t.Fatalf("err = %v, want %v", err, nats.ErrTimeout)
+		}
+	}
+	if calls != 6 {
+		t.Fatalf("calls = %d, want 3 attempts per call", calls)
+	}
+
+	if _, err := call(context.Background(), nil); !errors.Is(err, gobreaker.ErrOpenState) || !Unavailable(err) {
+		t.Fatalf("err = %v, want the open breaker", err)
+	}
+}
+
+func TestResilientMiddlewarePassesOtherErrorsThrough(t *testing.T) {
+	for name, want := range map[string]error{
+		"canceled":  context.Canceled,
+		"malformed": &json.SyntaxError{},
+	} {
+		t.Run(name, func(t *testing.T) {
+			calls := 0
+			call := NewResilientMiddleware("test", testPolicy, log.NewNopLogger())(func(context.Context, interface{}) (interface{}, error) {
+				calls++
+				return nil, want
+			})
+
+			for i := 0; i < 3; i++ {
+				if _, err := call(context.Background(), nil); err != want {
+					t.Fatalf("err = %v, want %v as is", err, want)
+				}
+			}
+			if calls != 3 {
+				t.Fatalf("calls = %d, want one attempt per call and a closed breaker", calls)
+			}
+		})
+	}
+}
@@ -0,0 +1,41 @@
+package main
+
+import (
+	"github.com/SpawNKZ/content_service/common/openapi"
+	content "github.com/SpawNKZ/content_service/content/transports"
+	contentHistory "github.com/SpawNKZ/content_service/content_history/transports"
+	contentStatus "github.com/SpawNKZ/content_service/content_status/transports"
+	post "github.com/SpawNKZ/content_service/post/transports"
+	postCategory "github.com/SpawNKZ/content_service/post_category/transports"
+	review "github.com/SpawNKZ/content_service/review/transports"
+	"github.com/go-kit/kit/endpoint"
+	"github.com/go-kit/log"
+	"net/http"
+	"testing"
+)
+
+// TestOpenAPIDocumentsEveryRoute keeps the served document in line with the
+// routers, serve refuses to start otherwise.
+func TestOpenAPIDocumentsEveryRoute(t *testing.T) {
+	authMiddleware := endpoint.Middleware(func(next endpoint.Endpoint) endpoint.Endpoint { return next })
+	logger := log.NewNopLogger()
+
+	for _, tt := range []struct {
+		name       string
+		handler    http.Handler
+		operations []openapi.Operation
+	}{
+		{"content_status", contentStatus.MakeHTTPHandler(nil, authMiddleware, logger), contentStatus.OpenAPIOperations()},
+		{"content_history", contentHistory.MakeHTTPHandler(nil, authMiddleware, logger), contentHistory.OpenAPIOperations()},
+		{"content", content.MakeHTTPHandler(nil, authMiddleware, logger), content.OpenAPIOperations()},
+		{"post_category", postCategory.MakeHTTPHandler(nil, authMiddleware, logger), postCategory.OpenAPIOperations()},
+		{"post", post.MakeHTTPHandler(nil, authMiddleware, logger), post.OpenAPIOperations()},
+		{"review", review.MakeHTTPHandler(nil, authMiddleware, logger), review.OpenAPIOperations()},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := openapi.Verify(tt.handler, tt.operations); err != nil {
+				t.Fatal(err)
+			}
+		})
+	}
+}
@@ -0,0 +1,258 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             (unknown)
+// source: content_status.proto
+
+package pb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	ContentStatusService_Create_FullMethodName = "/content_service.v1.ContentStatusService/Create"
+	ContentStatusService_Get_FullMethodName    = "/content_service.v1.ContentStatusService/Get"
+	ContentStatusService_Update_FullMethodName = "/content_service.v1.ContentStatusService/Update"
+	ContentStatusService_Delete_FullMethodName = "/content_service.v1.ContentStatusService/Delete"
+	ContentStatusService_List_FullMethodName   = "/content_service.v1.ContentStatusService/List"
+)
+
+// ContentStatusServiceClient is the client API for ContentStatusService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type ContentStatusServiceClient interface {
+	Create(ctx context.Context, in *CreateContentStatusRequest, opts ...grpc.CallOption) (*Created, error)
+	Get(ctx context.Context, in *GetContentStatusRequest, opts ...grpc.CallOption) (*ContentStatus, error)
+	Update(ctx context.Context, in *UpdateContentStatusRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	Delete(ctx context.Context, in *IdRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	List(ctx context.Context, in *ListContentStatusRequest, opts ...grpc.CallOption) (*ContentStatusList, error)
+}
+
+type contentStatusServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewContentStatusServiceClient(cc grpc.ClientConnInterface) ContentStatusServiceClient {
+	return &contentStatusServiceClient{cc}
+}
+
+func (c *contentStatusServiceClient) Create(ctx context.Context, in *CreateContentStatusRequest, opts ...grpc.CallOption) (*Created, error) {
+	out := new(Created)
+	err := c.cc.Invoke(ctx, ContentStatusService_Create_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *contentStatusServiceClient) Get(ctx context.Context, in *GetContentStatusRequest, opts ...grpc.CallOption) (*ContentStatus, error) {
+	out := new(ContentStatus)
+	err := c.cc.Invoke(ctx, ContentStatusService_Get_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *contentStatusServiceClient) Update(ctx context.Context, in *UpdateContentStatusRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, ContentStatusService_Update_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *contentStatusServiceClient) Delete(ctx context.Context, in *IdRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, ContentStatusService_Delete_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *contentStatusServiceClient) List(ctx context.Context, in *ListContentStatusRequest, opts ...grpc.CallOption) (*ContentStatusList, error) {
+	out := new(ContentStatusList)
+	err := c.cc.Invoke(ctx, ContentStatusService_List_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ContentStatusServiceServer is the server API for ContentStatusService service.
+// All implementations must embed UnimplementedContentStatusServiceServer
+// for forward compatibility
+type ContentStatusServiceServer interface {
+	Create(context.Context, *CreateContentStatusRequest) (*Created, error)
+	Get(context.Context, *GetContentStatusRequest) (*ContentStatus, error)
+	Update(context.Context, *UpdateContentStatusRequest) (*emptypb.Empty, error)
+	Delete(context.Context, *IdRequest) (*emptypb.Empty, error)
+	List(context.Context, *ListContentStatusRequest) (*ContentStatusList, error)
+	mustEmbedUnimplementedContentStatusServiceServer()
+}
+
+// UnimplementedContentStatusServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedContentStatusServiceServer struct {
+}
+
+func (UnimplementedContentStatusServiceServer) Create(context.Context, *CreateContentStatusRequest) (*Created, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Create not implemented")
+}
+func (UnimplementedContentStatusServiceServer) Get(context.Context, *GetContentStatusRequest) (*ContentStatus, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Get not implemented")
+}
+func (UnimplementedContentStatusServiceServer) Update(context.Context, *UpdateContentStatusRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Update not implemented")
+}
+func (UnimplementedContentStatusServiceServer) Delete(context.Context, *IdRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Delete not implemented")
+}
+func (UnimplementedContentStatusServiceServer) List(context.Context, *ListContentStatusRequest) (*ContentStatusList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method List not implemented")
+}
+func (UnimplementedContentStatusServiceServer) mustEmbedUnimplementedContentStatusServiceServer() {}
+
+// UnsafeContentStatusServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ContentStatusServiceServer will
+// result in compilation errors.
+type UnsafeContentStatusServiceServer interface {
+	mustEmbedUnimplementedContentStatusServiceServer()
+}
+
+func RegisterContentStatusServiceServer(s grpc.ServiceRegistrar, srv ContentStatusServiceServer) {
+	s.RegisterService(&ContentStatusService_ServiceDesc, srv)
+}
+
+func _ContentStatusService_Create_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateContentStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ContentStatusServiceServer).Create(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ContentStatusService_Create_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ContentStatusServiceServer).Create(ctx, req.(*CreateContentStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ContentStatusService_Get_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetContentStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ContentStatusServiceServer).Get(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ContentStatusService_Get_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ContentStatusServiceServer).Get(ctx, req.(*GetContentStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ContentStatusService_Update_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateContentStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ContentStatusServiceServer).Update(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ContentStatusService_Update_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ContentStatusServiceServer).Update(ctx, req.(*UpdateContentStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ContentStatusService_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ContentStatusServiceServer).Delete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ContentStatusService_Delete_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ContentStatusServiceServer).Delete(ctx, req.(*IdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ContentStatusService_List_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListContentStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ContentStatusServiceServer).List(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ContentStatusService_List_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ContentStatusServiceServer).List(ctx, req.(*ListContentStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// ContentStatusService_ServiceDesc is the grpc.ServiceDesc for ContentStatusService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var ContentStatusService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "content_service.v1.ContentStatusService",
+	HandlerType: (*ContentStatusServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Create",
+			Handler:    _ContentStatusService_Create_Handler,
+		},
+		{
+			MethodName: "Get",
+			Handler:    _ContentStatusService_Get_Handler,
+		},
+		{
+			MethodName: "Update",
+			Handler:    _ContentStatusService_Update_Handler,
+		},
+		{
+			MethodName: "Delete",
+			Handler:    _ContentStatusService_Delete_Handler,
+		},
+		{
+			MethodName: "List",
+			Handler:    _ContentStatusService_List_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "content_status.proto",
+}
@@ -0,0 +1,5 @@
+// Package pb holds the protobuf messages and gRPC services generated from the
+// definitions in the proto directory.
+package pb
+
+//go:generate protoc -I ../proto --go_out=. --go_opt=paths=source_relative --go-grpc_out=. --go-grpc_opt=paths=source_relative ../proto/pagination.proto ../proto/request.proto ../proto/result.proto ../proto/translation.proto ../proto/content.proto ../proto/post.proto ../proto/content_status.proto
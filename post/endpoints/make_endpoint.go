@@ -43,6 +43,18 @@ func MakeGetListEndpoint(s postService.Service) endpoint.Endpoint {
 	return func(ctx context.Context, request interface{}) (response interface{}, err error) {
 		req := request.(models.GetListRequest)
 		res, pagination, e := s.GetList(ctx, req)
-		return models.GetListResponse{PostList: res, Pagination: pagination, Err: e}, nil
+		if e != nil {
+			return models.GetListResponse{Err: e}, nil
+		}
+		counts, e := s.CountByCategory(ctx, req.PostFilter)
+		return models.GetListResponse{PostList: res, CategoryCounts: counts, Pagination: pagination, Err: e}, nil
+	}
+}
+
+func MakeReorderEndpoint(s postService.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (response interface{}, err error) {
+		req := request.(models.ReorderRequest)
+		e := s.Reorder(ctx, req)
+		return models.UpdateResponse{Err: e}, nil
 	}
 }
@@ -7,9 +7,14 @@ import (
 
 type PostModel struct {
 	ID          primitive.ObjectID `bson:"_id"`
-	Category    string             `bson:"category"`
+	CategoryId  string             `bson:"category_id"`
 	Resources   []string           `bson:"resources"`
 	ContentId   string             `bson:"content_id"`
 	Description string             `bson:"description"`
+	Position    int                `bson:"position"`
+	CreatedBy   string             `bson:"created_by"`
+	UpdatedBy   string             `bson:"updated_by"`
+	CreatedAt   time.Time          `bson:"created_at"`
+	UpdatedAt   time.Time          `bson:"updated_at"`
 	DeletedAt   *time.Time         `bson:"deleted_at"`
 }
@@ -2,15 +2,21 @@ package models
 
 import (
 	"go.mongodb.org/mongo-driver/bson/primitive"
+	"time"
 )
 
 func (pt *Post) ToRepositoryModel() *PostModel {
 	return &PostModel{
 		ID:          primitive.NewObjectID(),
-		Category:    pt.Category,
+		CategoryId:  pt.CategoryId,
 		Description: pt.Description,
 		Resources:   pt.Resources,
 		ContentId:   pt.ContentId,
+		Position:    pt.Position,
+		CreatedBy:   pt.CreatedBy,
+		UpdatedBy:   pt.CreatedBy,
+		CreatedAt:   time.Now(),
+		UpdatedAt:   time.Now(),
 	}
 }
 
@@ -18,22 +24,32 @@ func (pt *Post) FromRepositoryModel(repoPt *PostModel) {
 	pt.ID = repoPt.ID.Hex()
 	pt.Description = repoPt.Description
 	pt.Resources = repoPt.Resources
-	pt.Category = repoPt.Category
+	pt.CategoryId = repoPt.CategoryId
 	pt.ContentId = repoPt.ContentId
+	pt.Position = repoPt.Position
+	pt.CreatedBy = repoPt.CreatedBy
+	pt.UpdatedBy = repoPt.UpdatedBy
+	pt.CreatedAt = repoPt.CreatedAt
+	pt.UpdatedAt = repoPt.UpdatedAt
 }
 
 func (pt *CreateRequest) ToPost() *Post {
 	return &Post{
 		Description: pt.Description,
 		Resources:   pt.Resources,
-		Category:    pt.Category,
+		CategoryId:  pt.CategoryId,
 		ContentId:   pt.ContentId,
+		CreatedBy:   pt.CreatedBy,
 	}
 }
 
 func (ur *UpdateRequest) ToPost() map[string]interface{} {
 	args := make(map[string]interface{})
 
+	if ur.CategoryId != "" {
+		args["category_id"] = ur.CategoryId
+	}
+
 	if ur.Description != "" {
 		args["description"] = ur.Description
 	}
@@ -42,5 +58,7 @@ func (ur *UpdateRequest) ToPost() map[string]interface{} {
 		args["resources"] = ur.Resources
 	}
 
+	args["updated_by"] = ur.UpdatedBy
+	args["updated_at"] = time.Now()
 	return args
 }
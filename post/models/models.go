@@ -1,11 +1,21 @@
 package models
 
+import (
+	"github.com/SpawNKZ/content_service/common/pagination"
+	"time"
+)
+
 type Post struct {
-	ID          string   `json:"id"`
-	Category    string   `json:"category"`
-	Resources   []string `json:"resources"`
-	ContentId   string   `json:"content_id"`
-	Description string   `json:"description"`
+	ID          string    `json:"id"`
+	CategoryId  string    `json:"category_id"`
+	Resources   []string  `json:"resources"`
+	ContentId   string    `json:"content_id"`
+	Description string    `json:"description"`
+	Position    int       `json:"position"`
+	CreatedBy   string    `json:"created_by"`
+	UpdatedBy   string    `json:"updated_by"`
+	CreatedAt   time.Time `json:"created_at"`
+	UpdatedAt   time.Time `json:"updated_at"`
 }
 
 type Pagination struct {
@@ -16,7 +26,7 @@ type Pagination struct {
 }
 
 const (
-	DefaultLimit = 10
-	MaxOffset    = 2147483647
-	MaxLimit     = 1000
+	DefaultLimit = pagination.DefaultLimit
+	MaxOffset    = pagination.MaxOffset
+	MaxLimit     = pagination.MaxLimit
 )
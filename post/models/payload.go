@@ -1,10 +1,14 @@
 package models
 
 type CreateRequest struct {
-	Category    string   `json:"category"`
-	Resources   []string `json:"resources"`
-	ContentId   string   `json:"content_id"`
-	Description string   `json:"description"`
+	CategoryId  string   `json:"category_id" validate:"required,objectid"`
+	Resources   []string `json:"resources" validate:"max=50,dive,url"`
+	ContentId   string   `json:"content_id" validate:"required,objectid"`
+	Description string   `json:"description" validate:"max=5000"`
+	// Position is where the post is inserted among the posts of the content,
+	// the post is appended when it is omitted.
+	Position  *int   `json:"position" validate:"omitempty,min=0"`
+	CreatedBy string `json:"-"`
 }
 
 type IdRequest struct {
@@ -13,8 +17,15 @@ type IdRequest struct {
 
 type UpdateRequest struct {
 	ID          string
-	Description string   `json:"description"`
-	Resources   []string `json:"resources"`
+	CategoryId  string   `json:"category_id" validate:"omitempty,objectid"`
+	Description string   `json:"description" validate:"max=5000"`
+	Resources   []string `json:"resources" validate:"max=50,dive,url"`
+	UpdatedBy   string   `json:"-"`
+}
+
+type ReorderRequest struct {
+	ContentId string   `json:"content_id" validate:"required,objectid"`
+	PostIds   []string `json:"post_ids" validate:"required,unique,dive,objectid"`
 }
 
 type ReqPagination struct {
@@ -23,7 +34,8 @@ type ReqPagination struct {
 }
 
 type PostFilter struct {
-	ContentId string `bson:"content_id"`
+	ContentId  string `bson:"content_id"`
+	CategoryId string `bson:"category_id"`
 }
 
 type GetListRequest struct {
@@ -0,0 +1,60 @@
+package models
+
+import (
+	"github.com/SpawNKZ/content_service/pb"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (p *Post) ToProto() *pb.Post {
+	return &pb.Post{
+		Id:          p.ID,
+		CategoryId:  p.CategoryId,
+		Resources:   p.Resources,
+		ContentId:   p.ContentId,
+		Description: p.Description,
+		Position:    int32(p.Position),
+		CreatedBy:   p.CreatedBy,
+		UpdatedBy:   p.UpdatedBy,
+		CreatedAt:   timestamppb.New(p.CreatedAt),
+		UpdatedAt:   timestamppb.New(p.UpdatedAt),
+	}
+}
+
+func (p Pagination) ToProto() *pb.Pagination {
+	return &pb.Pagination{
+		Total:      int32(p.Total),
+		Limit:      int32(p.Limit),
+		Offset:     int32(p.Offset),
+		IsLastPage: p.IsLastPage,
+	}
+}
+
+func (r CreateResponse) ToProto() proto.Message {
+	return &pb.Created{Id: r.ID}
+}
+
+func (r GetOneResponse) ToProto() proto.Message {
+	return r.Post.ToProto()
+}
+
+func (r UpdateResponse) ToProto() proto.Message {
+	return nil
+}
+
+func (r DeleteOneResponse) ToProto() proto.Message {
+	return nil
+}
+
+func (r GetListResponse) ToProto() proto.Message {
+	postList := make([]*pb.Post, 0, len(r.PostList))
+	for _, postObj := range r.PostList {
+		postList = append(postList, postObj.ToProto())
+	}
+
+	return &pb.PostList{
+		Post:           postList,
+		CategoryCounts: r.CategoryCounts,
+		Pagination:     r.Pagination.ToProto(),
+	}
+}
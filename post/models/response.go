@@ -40,6 +40,8 @@ func (r DeleteOneResponse) Failed() error { return r.Err }
 
 type GetListResponse struct {
 	PostList []*Post `json:"post,omitempty"`
+	// CategoryCounts is the number of posts per category ID.
+	CategoryCounts map[string]int64 `json:"category_counts"`
 	Pagination
 	Err error `json:"error,omitempty"`
 }
@@ -13,39 +13,147 @@ import (
 )
 
 type repository struct {
-	c *mongo.Collection
+	c       *mongo.Collection
+	content *mongo.Collection
 }
 
 type Repository interface {
-	Insert(ctx context.Context, postObj models.Post) (string, error)
+	Insert(ctx context.Context, postObj models.Post, position *int) (string, error)
+	Reorder(ctx context.Context, contentId string, postIds []string) error
 	FindByID(ctx context.Context, id string) (*models.Post, error)
 	Update(ctx context.Context, updateObj models.UpdateRequest) error
 	DeleteByID(ctx context.Context, id string) error
 	Count(ctx context.Context, filter models.PostFilter) (int64, error)
+	CountByCategory(ctx context.Context, filter models.PostFilter) (map[string]int64, error)
 	GetAll(ctx context.Context, limit int64, offset int64, filter models.PostFilter) ([]*models.Post, error)
 }
 
 func NewRepository(client *mongo.Client) Repository {
+	database := client.Database("core")
 	return &repository{
-		client.Database("core").Collection("post"),
+		c:       database.Collection("post"),
+		content: database.Collection("content"),
 	}
 }
 
-func (r *repository) Insert(ctx context.Context, postObj models.Post) (string, error) {
+// Insert places the post at the given position among the posts of its content,
+// shifting the following posts, or appends it when position is nil.
+func (r *repository) Insert(ctx context.Context, postObj models.Post, position *int) (string, error) {
 	postModel := postObj.ToRepositoryModel()
 
-	result, err := r.c.InsertOne(ctx, postModel)
+	err := r.withTransaction(ctx, func(ctx mongo.SessionContext) error {
+		if err := r.countPosts(ctx, postObj.ContentId, 1); err != nil {
+			return err
+		}
+
+		count, err := r.c.CountDocuments(ctx, bson.M{"content_id": postObj.ContentId, "deleted_at": nil})
+		if err != nil {
+			return err
+		}
+
+		postModel.Position = int(count)
+		if position != nil && *position >= 0 && *position < int(count) {
+			postModel.Position = *position
+		}
+
+		_, err = r.c.UpdateMany(ctx,
+			bson.M{"content_id": postObj.ContentId, "deleted_at": nil, "position": bson.M{"$gte": postModel.Position}},
+			bson.M{"$inc": bson.M{"position": 1}},
+		)
+		if err != nil {
+			return err
+		}
+
+		_, err = r.c.InsertOne(ctx, postModel)
+		return err
+	})
 	if err != nil {
 		return "", err
 	}
 
-	return result.InsertedID.(primitive.ObjectID).Hex(), err
+	return postModel.ID.Hex(), nil
+}
+
+// Reorder assigns positions to all posts of the content in the order of postIds.
+// postIds must list every post of the content exactly once.
+func (r *repository) Reorder(ctx context.Context, contentId string, postIds []string) error {
+	objectIds := make([]primitive.ObjectID, 0, len(postIds))
+	seen := make(map[primitive.ObjectID]bool, len(postIds))
+	for _, id := range postIds {
+		objectId, err := primitive.ObjectIDFromHex(id)
+		if err != nil {
+			return errors.ErrInvalidID.Wrap(err)
+		}
+		if seen[objectId] {
+			return errors.ErrInconsistentIDs
+		}
+		seen[objectId] = true
+		objectIds = append(objectIds, objectId)
+	}
+
+	return r.withTransaction(ctx, func(ctx mongo.SessionContext) error {
+		count, err := r.c.CountDocuments(ctx, bson.M{"content_id": contentId, "deleted_at": nil, "_id": bson.M{"$in": objectIds}})
+		if err != nil {
+			return err
+		}
+
+		total, err := r.c.CountDocuments(ctx, bson.M{"content_id": contentId, "deleted_at": nil})
+		if err != nil {
+			return err
+		}
+
+		if count != int64(len(objectIds)) || total != count {
+			return errors.ErrInconsistentIDs
+		}
+
+		writes := make([]mongo.WriteModel, 0, len(objectIds))
+		for position, objectId := range objectIds {
+			writes = append(writes, mongo.NewUpdateOneModel().
+				SetFilter(bson.M{"_id": objectId}).
+				SetUpdate(bson.M{"$set": bson.M{"position": position}}))
+		}
+
+		_, err = r.c.BulkWrite(ctx, writes)
+		return err
+	})
+}
+
+// countPosts moves the post_count of the content by delta. Writing the content
+// document makes concurrent inserts and deletes of its posts conflict: the
+// transaction of the later one is retried and sees the positions of the first.
+func (r *repository) countPosts(ctx mongo.SessionContext, contentId string, delta int) error {
+	objectId, err := primitive.ObjectIDFromHex(contentId)
+	if err != nil {
+		return errors.ErrInvalidID.Wrap(err)
+	}
+
+	updateResult, err := r.content.UpdateOne(ctx, bson.M{"_id": objectId}, bson.M{"$inc": bson.M{"post_count": delta}})
+	if err != nil {
+		return err
+	}
+	if updateResult.MatchedCount == 0 {
+		return errors.ErrNotFound
+	}
+	return nil
+}
+
+func (r *repository) withTransaction(ctx context.Context, fn func(ctx mongo.SessionContext) error) error {
+	session, err := r.c.Database().Client().StartSession()
+	if err != nil {
+		return err
+	}
+	defer session.EndSession(ctx)
+
+	_, err = session.WithTransaction(ctx, func(ctx mongo.SessionContext) (interface{}, error) {
+		return nil, fn(ctx)
+	})
+	return err
 }
 
 func (r *repository) FindByID(ctx context.Context, id string) (*models.Post, error) {
 	objectId, err := primitive.ObjectIDFromHex(id)
 	if err != nil {
-		return nil, err
+		return nil, errors.ErrInvalidID.Wrap(err)
 	}
 
 	filter := bson.D{{"_id", objectId}, {"deleted_at", nil}}
@@ -86,6 +194,42 @@ func (r *repository) Count(ctx context.Context, filter models.PostFilter) (int64
 	return count, nil
 }
 
+func (r *repository) CountByCategory(ctx context.Context, filter models.PostFilter) (map[string]int64, error) {
+	filterQuery := bson.M{"deleted_at": nil}
+	if filter.ContentId != "" {
+		filterQuery["content_id"] = filter.ContentId
+	}
+
+	pipeline := mongo.Pipeline{
+		{{Key: "$match", Value: filterQuery}},
+		{{Key: "$group", Value: bson.M{"_id": "$category_id", "count": bson.M{"$sum": 1}}}},
+	}
+
+	cursor, err := r.c.Aggregate(ctx, pipeline)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	counts := make(map[string]int64)
+	for cursor.Next(ctx) {
+		var group struct {
+			CategoryId string `bson:"_id"`
+			Count      int64  `bson:"count"`
+		}
+		if err := cursor.Decode(&group); err != nil {
+			return nil, err
+		}
+		counts[group.CategoryId] = group.Count
+	}
+
+	if err := cursor.Err(); err != nil {
+		return nil, err
+	}
+
+	return counts, nil
+}
+
 func (r *repository) GetAll(ctx context.Context, limit int64, offset int64, filter models.PostFilter) ([]*models.Post, error) {
 	filterQuery := bson.M{"deleted_at": nil}
 	filterValue := reflect.ValueOf(filter)
@@ -102,6 +246,7 @@ func (r *repository) GetAll(ctx context.Context, limit int64, offset int64, filt
 	options := options.Find()
 	options.SetSkip(offset)
 	options.SetLimit(limit)
+	options.SetSort(bson.D{{Key: "position", Value: 1}, {Key: "_id", Value: 1}})
 
 	cursor, err := r.c.Find(ctx, filterQuery, options)
 	if err != nil {
@@ -136,7 +281,7 @@ func (r *repository) GetAll(ctx context.Context, limit int64, offset int64, filt
 func (r *repository) Update(ctx context.Context, updateObj models.UpdateRequest) error {
 	objectId, err := primitive.ObjectIDFromHex(updateObj.ID)
 	if err != nil {
-		return err
+		return errors.ErrInvalidID.Wrap(err)
 	}
 	filter := bson.D{{"_id", objectId}}
 
@@ -160,16 +305,37 @@ func (r *repository) Update(ctx context.Context, updateObj models.UpdateRequest)
 func (r *repository) DeleteByID(ctx context.Context, id string) error {
 	objectId, err := primitive.ObjectIDFromHex(id)
 	if err != nil {
-		return err
+		return errors.ErrInvalidID.Wrap(err)
 	}
 	filter := bson.D{{"_id", objectId}}
 
-	updateResult, err := r.c.UpdateOne(ctx, filter, bson.D{{"$set", bson.D{{"deleted_at", time.Now()}}}})
-	if err != nil {
+	return r.withTransaction(ctx, func(ctx mongo.SessionContext) error {
+		var postObj models.PostModel
+		err := r.c.FindOne(ctx, bson.M{"_id": objectId, "deleted_at": nil}).Decode(&postObj)
+		switch {
+		case err == mongo.ErrNoDocuments:
+			return errors.ErrNotFound
+		case err != nil:
+			return err
+		}
+
+		if err := r.countPosts(ctx, postObj.ContentId, -1); err != nil {
+			return err
+		}
+
+		updateResult, err := r.c.UpdateOne(ctx, filter, bson.D{{"$set", bson.D{{"deleted_at", time.Now()}}}})
+		if err != nil {
+			return err
+		}
+		if updateResult.ModifiedCount == 0 {
+			return errors.ErrNotFound
+		}
+
+		// Close the gap left by the deleted post.
+		_, err = r.c.UpdateMany(ctx,
+			bson.M{"content_id": postObj.ContentId, "deleted_at": nil, "position": bson.M{"$gt": postObj.Position}},
+			bson.M{"$inc": bson.M{"position": -1}},
+		)
 		return err
-	}
-	if updateResult.ModifiedCount == 0 {
-		return errors.ErrNotFound
-	}
-	return nil
+	})
 }
@@ -0,0 +1,22 @@
+package service
+
+import (
+	"context"
+	"github.com/SpawNKZ/content_service/post/models"
+	repository "github.com/SpawNKZ/content_service/post/repo"
+	postCategory "github.com/SpawNKZ/content_service/post_category/service"
+)
+
+type categoryUsage struct {
+	repo repository.Repository
+}
+
+// NewCategoryUsage lets the post category service check the posts using a
+// category before deleting it.
+func NewCategoryUsage(repo repository.Repository) postCategory.PostUsage {
+	return &categoryUsage{repo: repo}
+}
+
+func (u *categoryUsage) CountByCategory(ctx context.Context, categoryId string) (int64, error) {
+	return u.repo.Count(ctx, models.PostFilter{CategoryId: categoryId})
+}
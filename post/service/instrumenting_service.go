@@ -0,0 +1,76 @@
+package service
+
+import (
+	"context"
+	"github.com/SpawNKZ/content_service/common/instrumenting"
+	"github.com/SpawNKZ/content_service/post/models"
+	"time"
+)
+
+type instrumentingService struct {
+	metrics instrumenting.RequestMetrics
+	service Service
+}
+
+func NewInstrumentingService(metrics instrumenting.RequestMetrics, service Service) Service {
+	return &instrumentingService{
+		metrics: metrics,
+		service: service,
+	}
+}
+
+func (s *instrumentingService) Create(ctx context.Context, request models.CreateRequest) (result string, err error) {
+	defer func(begin time.Time) {
+		s.metrics.Observe("Create", begin, err)
+	}(time.Now())
+
+	return s.service.Create(ctx, request)
+}
+
+func (s *instrumentingService) GetOne(ctx context.Context, request models.IdRequest) (result *models.Post, err error) {
+	defer func(begin time.Time) {
+		s.metrics.Observe("GetOne", begin, err)
+	}(time.Now())
+
+	return s.service.GetOne(ctx, request)
+}
+
+func (s *instrumentingService) GetList(ctx context.Context, request models.GetListRequest) (result []*models.Post, pagination models.Pagination, err error) {
+	defer func(begin time.Time) {
+		s.metrics.Observe("GetList", begin, err)
+	}(time.Now())
+
+	return s.service.GetList(ctx, request)
+}
+
+func (s *instrumentingService) Update(ctx context.Context, request models.UpdateRequest) (err error) {
+	defer func(begin time.Time) {
+		s.metrics.Observe("Update", begin, err)
+	}(time.Now())
+
+	return s.service.Update(ctx, request)
+}
+
+func (s *instrumentingService) Delete(ctx context.Context, request models.IdRequest) (err error) {
+	defer func(begin time.Time) {
+		s.metrics.Observe("Delete", begin, err)
+	}(time.Now())
+
+	return s.service.Delete(ctx, request)
+}
+
+func (s *instrumentingService) CountByCategory(ctx context.Context, request models.PostFilter) (result map[string]int64, err error) {
+	defer func(begin time.Time) {
+		s.metrics.Observe("CountByCategory", begin, err)
+	}(time.Now())
+
+	return s.service.CountByCategory(ctx, request)
+}
+
+func (s *instrumentingService) Reorder(ctx context.Context, request models.ReorderRequest) (err error) {
+	defer func(begin time.Time) {
+		s.metrics.Observe("Reorder", begin, err)
+	}(time.Now())
+
+	return s.service.Reorder(ctx, request)
+}
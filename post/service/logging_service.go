@@ -78,3 +78,28 @@ func (l *loggingService) Delete(ctx context.Context, request models.IdRequest) (
 
 	return l.service.Delete(ctx, request)
 }
+
+func (l *loggingService) CountByCategory(ctx context.Context, request models.PostFilter) (result map[string]int64, err error) {
+	defer func(begin time.Time) {
+		l.logger.Log(
+			"method", "CountByCategory",
+			"categories", len(result),
+			"err", err,
+		)
+	}(time.Now())
+
+	return l.service.CountByCategory(ctx, request)
+}
+
+func (l *loggingService) Reorder(ctx context.Context, request models.ReorderRequest) (err error) {
+	defer func(begin time.Time) {
+		l.logger.Log(
+			"method", "Reorder",
+			"content_id", request.ContentId,
+			"post", len(request.PostIds),
+			"err", err,
+		)
+	}(time.Now())
+
+	return l.service.Reorder(ctx, request)
+}
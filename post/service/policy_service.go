@@ -0,0 +1,102 @@
+package service
+
+import (
+	"context"
+	"github.com/SpawNKZ/content_service/common/auth"
+	"github.com/SpawNKZ/content_service/common/errors"
+	contentModel "github.com/SpawNKZ/content_service/content/models"
+	contentService "github.com/SpawNKZ/content_service/content/service"
+	"github.com/SpawNKZ/content_service/post/models"
+)
+
+// policyService enforces who may modify posts: the author and the
+// contributors of their content, and admins. Everyone may read them.
+type policyService struct {
+	service    Service
+	contentSvc contentService.Service
+}
+
+func NewPolicyService(service Service, contentSvc contentService.Service) Service {
+	return &policyService{
+		service:    service,
+		contentSvc: contentSvc,
+	}
+}
+
+func (p *policyService) Create(ctx context.Context, data models.CreateRequest) (string, error) {
+	if err := p.authorize(ctx, data.ContentId); err != nil {
+		return "", err
+	}
+	return p.service.Create(ctx, data)
+}
+
+func (p *policyService) GetOne(ctx context.Context, data models.IdRequest) (*models.Post, error) {
+	return p.service.GetOne(ctx, data)
+}
+
+func (p *policyService) GetList(ctx context.Context, data models.GetListRequest) ([]*models.Post, models.Pagination, error) {
+	return p.service.GetList(ctx, data)
+}
+
+func (p *policyService) CountByCategory(ctx context.Context, data models.PostFilter) (map[string]int64, error) {
+	return p.service.CountByCategory(ctx, data)
+}
+
+func (p *policyService) Update(ctx context.Context, data models.UpdateRequest) error {
+	if err := p.authorizePost(ctx, data.ID); err != nil {
+		return err
+	}
+	return p.service.Update(ctx, data)
+}
+
+func (p *policyService) Delete(ctx context.Context, data models.IdRequest) error {
+	if err := p.authorizePost(ctx, data.ID); err != nil {
+		return err
+	}
+	return p.service.Delete(ctx, data)
+}
+
+func (p *policyService) Reorder(ctx context.Context, data models.ReorderRequest) error {
+	if err := p.authorize(ctx, data.ContentId); err != nil {
+		return err
+	}
+	return p.service.Reorder(ctx, data)
+}
+
+func (p *policyService) authorizePost(ctx context.Context, id string) error {
+	if _, ok := auth.FromContext(ctx); !ok {
+		return errors.ErrUnauthorized
+	}
+
+	postObj, err := p.service.GetOne(ctx, models.IdRequest{ID: id})
+	if err != nil {
+		return err
+	}
+	return p.authorize(ctx, postObj.ContentId)
+}
+
+// authorize allows admins, the author and the contributors of the content.
+func (p *policyService) authorize(ctx context.Context, contentId string) error {
+	identity, ok := auth.FromContext(ctx)
+	if !ok {
+		return errors.ErrUnauthorized
+	}
+	if identity.IsAdmin() {
+		return nil
+	}
+
+	contentObj, err := p.contentSvc.GetOne(ctx, contentModel.IdRequest{ID: contentId})
+	if err != nil {
+		return err
+	}
+
+	if contentObj.AuthorId == identity.UserId {
+		return nil
+	}
+	for _, contributor := range contentObj.Contributors {
+		if contributor.UserId == identity.UserId {
+			return nil
+		}
+	}
+	return errors.ErrForbidden
+}
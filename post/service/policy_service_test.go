@@ -0,0 +1,83 @@
+package service
+
+import (
+	"context"
+	"github.com/SpawNKZ/content_service/common/auth"
+	"github.com/SpawNKZ/content_service/common/errors"
+	"github.com/SpawNKZ/content_service/post/models"
+	"testing"
+)
+
+func TestPolicy(t *testing.T) {
+	tests := []struct {
+		name string
+		ctx  context.Context
+		want *errors.AppError
+	}{
+		{"anonymous", context.Background(), errors.ErrUnauthorized},
+		{"stranger", withUser("mallory", auth.GroupAuthor), errors.ErrForbidden},
+		{"author", withUser("alice"), nil},
+		{"contributor", withUser("tom"), nil},
+		{"admin", withUser("root", auth.GroupAdmin), nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := newFixture("a", "b")
+
+			err := f.service.Update(tt.ctx, models.UpdateRequest{ID: "a"})
+			assertAppError(t, err, tt.want)
+			err = f.service.Reorder(tt.ctx, models.ReorderRequest{ContentId: contentId, PostIds: []string{"b", "a"}})
+			assertAppError(t, err, tt.want)
+			err = f.service.Delete(tt.ctx, models.IdRequest{ID: "a"})
+			assertAppError(t, err, tt.want)
+			_, err = f.service.Create(tt.ctx, models.CreateRequest{ContentId: contentId, CategoryId: categoryId})
+			assertAppError(t, err, tt.want)
+
+			if tt.want != nil && len(f.history.entries) != 0 {
+				t.Fatalf("refused writes were recorded: %+v", f.history.entries)
+			}
+		})
+	}
+}
+
+func TestWritesRecordTheActor(t *testing.T) {
+	f := newFixture("a")
+
+	id, err := f.service.Create(withUser("tom"), models.CreateRequest{ContentId: contentId, CategoryId: categoryId})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := f.repo.posts[id].CreatedBy; got != "tom" {
+		t.Fatalf("created_by = %q, want tom", got)
+	}
+
+	if err := f.service.Update(withUser("alice"), models.UpdateRequest{ID: id}); err != nil {
+		t.Fatal(err)
+	}
+	if got := f.repo.posts[id].UpdatedBy; got != "alice" {
+		t.Fatalf("updated_by = %q, want alice", got)
+	}
+
+	want := []string{"tom", "alice"}
+	if len(f.history.entries) != len(want) {
+		t.Fatalf("history = %+v, want %d entries", f.history.entries, len(want))
+	}
+	for i, entry := range f.history.entries {
+		if entry.UserId != want[i] || entry.PostId != id {
+			t.Fatalf("history[%d] = %+v, want the post written by %s", i, entry, want[i])
+		}
+	}
+}
+
+func assertAppError(t *testing.T, err error, want *errors.AppError) {
+	t.Helper()
+	if want == nil {
+		if err != nil {
+			t.Fatalf("err = %v, want nil", err)
+		}
+		return
+	}
+	if !errors.Is(err, want) {
+		t.Fatalf("err = %v, want %s", err, want.Code)
+	}
+}
@@ -2,11 +2,25 @@ package service
 
 import (
 	"context"
+	"github.com/SpawNKZ/content_service/common/auth"
 	"github.com/SpawNKZ/content_service/common/errors"
+	"github.com/SpawNKZ/content_service/common/pagination"
 	contentModel "github.com/SpawNKZ/content_service/content/models"
 	contentRepository "github.com/SpawNKZ/content_service/content/service"
+	contentHistoryModel "github.com/SpawNKZ/content_service/content_history/models"
+	contentHistory "github.com/SpawNKZ/content_service/content_history/service"
 	"github.com/SpawNKZ/content_service/post/models"
 	repository "github.com/SpawNKZ/content_service/post/repo"
+	postCategoryModel "github.com/SpawNKZ/content_service/post_category/models"
+	postCategory "github.com/SpawNKZ/content_service/post_category/service"
+	"time"
+)
+
+const (
+	actionCreate  = "post_create"
+	actionUpdate  = "post_update"
+	actionDelete  = "post_delete"
+	actionReorder = "post_reorder"
 )
 
 type Service interface {
@@ -14,18 +28,24 @@ type Service interface {
 	GetOne(ctx context.Context, data models.IdRequest) (*models.Post, error)
 	Update(ctx context.Context, data models.UpdateRequest) error
 	Delete(ctx context.Context, data models.IdRequest) error
+	Reorder(ctx context.Context, data models.ReorderRequest) error
 	GetList(ctx context.Context, data models.GetListRequest) ([]*models.Post, models.Pagination, error)
+	CountByCategory(ctx context.Context, data models.PostFilter) (map[string]int64, error)
 }
 
 type service struct {
-	repo       repository.Repository
-	contentSvc contentRepository.Service
+	repo        repository.Repository
+	contentSvc  contentRepository.Service
+	categorySvc postCategory.Service
+	history     contentHistory.Service
 }
 
-func New(repo repository.Repository, contentSvc contentRepository.Service) Service {
+func New(repo repository.Repository, contentSvc contentRepository.Service, categorySvc postCategory.Service, history contentHistory.Service) Service {
 	return &service{
-		repo:       repo,
-		contentSvc: contentSvc,
+		repo:        repo,
+		contentSvc:  contentSvc,
+		categorySvc: categorySvc,
+		history:     history,
 	}
 }
 
@@ -35,7 +55,23 @@ func (s *service) Create(ctx context.Context, data models.CreateRequest) (string
 		return "", err
 	}
 
-	return s.repo.Insert(ctx, *data.ToPost())
+	err = s.validateCategory(ctx, data.CategoryId)
+	if err != nil {
+		return "", err
+	}
+
+	data.CreatedBy = actorId(ctx)
+	postId, err := s.repo.Insert(ctx, *data.ToPost(), data.Position)
+	if err != nil {
+		return "", err
+	}
+
+	err = s.record(ctx, data.ContentId, postId, actionCreate)
+	if err != nil {
+		return "", err
+	}
+
+	return postId, nil
 }
 
 func (s *service) GetOne(ctx context.Context, data models.IdRequest) (*models.Post, error) {
@@ -43,6 +79,12 @@ func (s *service) GetOne(ctx context.Context, data models.IdRequest) (*models.Po
 }
 
 func (s *service) GetList(ctx context.Context, data models.GetListRequest) ([]*models.Post, models.Pagination, error) {
+	limit, offset, err := pagination.Normalize(data.Limit, data.Offset)
+	if err != nil {
+		return nil, models.Pagination{}, err
+	}
+	data.Limit, data.Offset = limit, offset
+
 	count, err := s.repo.Count(ctx, data.PostFilter)
 
 	if err != nil {
@@ -64,18 +106,86 @@ func (s *service) GetList(ctx context.Context, data models.GetListRequest) ([]*m
 	}, nil
 }
 
+func (s *service) CountByCategory(ctx context.Context, data models.PostFilter) (map[string]int64, error) {
+	counts, err := s.repo.CountByCategory(ctx, data)
+	if err != nil {
+		return nil, errors.ErrDB
+	}
+	return counts, nil
+}
+
 func (s *service) Update(ctx context.Context, data models.UpdateRequest) error {
-	_, err := s.GetOne(ctx, models.IdRequest{ID: data.ID})
+	postObj, err := s.GetOne(ctx, models.IdRequest{ID: data.ID})
+	if err != nil {
+		return err
+	}
+
+	if data.CategoryId != "" {
+		err = s.validateCategory(ctx, data.CategoryId)
+		if err != nil {
+			return err
+		}
+	}
+
+	data.UpdatedBy = actorId(ctx)
+	err = s.repo.Update(ctx, data)
 	if err != nil {
 		return err
 	}
-	return s.repo.Update(ctx, data)
+
+	return s.record(ctx, postObj.ContentId, data.ID, actionUpdate)
 }
 
 func (s *service) Delete(ctx context.Context, data models.IdRequest) error {
-	_, err := s.GetOne(ctx, models.IdRequest{ID: data.ID})
+	postObj, err := s.GetOne(ctx, models.IdRequest{ID: data.ID})
+	if err != nil {
+		return err
+	}
+
+	err = s.repo.DeleteByID(ctx, data.ID)
+	if err != nil {
+		return err
+	}
+
+	return s.record(ctx, postObj.ContentId, data.ID, actionDelete)
+}
+
+func (s *service) validateCategory(ctx context.Context, categoryId string) error {
+	_, err := s.categorySvc.GetOne(ctx, postCategoryModel.IdRequest{ID: categoryId})
+	if errors.Is(err, errors.ErrNotFound) {
+		return errors.ErrInvalidCategory
+	}
+	return err
+}
+
+func (s *service) Reorder(ctx context.Context, data models.ReorderRequest) error {
+	_, err := s.contentSvc.GetOne(ctx, contentModel.IdRequest{ID: data.ContentId})
+	if err != nil {
+		return err
+	}
+
+	err = s.repo.Reorder(ctx, data.ContentId, data.PostIds)
 	if err != nil {
 		return err
 	}
-	return s.repo.DeleteByID(ctx, data.ID)
+
+	return s.record(ctx, data.ContentId, "", actionReorder)
+}
+
+func (s *service) record(ctx context.Context, contentId string, postId string, action string) error {
+	return s.history.Create(ctx, contentHistoryModel.ContentHistory{
+		ContentId: contentId,
+		PostId:    postId,
+		UserId:    actorId(ctx),
+		Action:    action,
+		CreatedAt: time.Now(),
+	})
+}
+
+// actorId returns the authenticated user performing the request, if any.
+func actorId(ctx context.Context) string {
+	if identity, ok := auth.FromContext(ctx); ok {
+		return identity.UserId
+	}
+	return ""
 }
@@ -0,0 +1,277 @@
+package service
+
+import (
+	"context"
+	"github.com/SpawNKZ/content_service/common/errors"
+	"github.com/SpawNKZ/content_service/common/validation"
+	contentModel "github.com/SpawNKZ/content_service/content/models"
+	content "github.com/SpawNKZ/content_service/content/service"
+	contentHistoryModel "github.com/SpawNKZ/content_service/content_history/models"
+	"github.com/SpawNKZ/content_service/post/models"
+	postCategoryModel "github.com/SpawNKZ/content_service/post_category/models"
+	postCategory "github.com/SpawNKZ/content_service/post_category/service"
+	kitJwt "github.com/go-kit/kit/auth/jwt"
+	"github.com/golang-jwt/jwt/v4"
+	"reflect"
+	"strconv"
+	"testing"
+)
+
+const (
+	contentId  = "64b000000000000000000001"
+	categoryId = "64b000000000000000000002"
+)
+
+func TestReorder(t *testing.T) {
+	f := newFixture("a", "b", "c")
+
+	err := f.service.Reorder(withUser("alice"), models.ReorderRequest{ContentId: contentId, PostIds: []string{"c", "a", "b"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if got, want := f.repo.order(), []string{"c", "a", "b"}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("order = %v, want %v", got, want)
+	}
+	if len(f.history.entries) != 1 || f.history.entries[0].Action != actionReorder || f.history.entries[0].UserId != "alice" {
+		t.Fatalf("history = %+v, want one reorder by alice", f.history.entries)
+	}
+}
+
+func TestReorderRejectsPartialLists(t *testing.T) {
+	f := newFixture("a", "b", "c")
+
+	err := f.service.Reorder(withUser("alice"), models.ReorderRequest{ContentId: contentId, PostIds: []string{"c", "a"}})
+	if !errors.Is(err, errors.ErrInconsistentIDs) {
+		t.Fatalf("err = %v, want %s", err, errors.ErrInconsistentIDs.Code)
+	}
+	if got, want := f.repo.order(), []string{"a", "b", "c"}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("order = %v, want it unchanged", got)
+	}
+	if len(f.history.entries) != 0 {
+		t.Fatalf("failed reorder was recorded: %+v", f.history.entries)
+	}
+}
+
+func TestReorderUnknownContent(t *testing.T) {
+	f := newFixture("a")
+
+	err := f.service.Reorder(withUser("alice"), models.ReorderRequest{ContentId: "64b0000000000000000000ff", PostIds: []string{"a"}})
+	if !errors.Is(err, errors.ErrNotFound) {
+		t.Fatalf("err = %v, want %s", err, errors.ErrNotFound.Code)
+	}
+}
+
+func TestReorderRequestRejectsDuplicates(t *testing.T) {
+	id := "64b000000000000000000003"
+	err := validation.Validate(models.ReorderRequest{ContentId: contentId, PostIds: []string{id, id}})
+	if !errors.Is(err, errors.ErrValidation) {
+		t.Fatalf("err = %v, want %s", err, errors.ErrValidation.Code)
+	}
+}
+
+func TestCreateInsertsAtPosition(t *testing.T) {
+	f := newFixture("a", "b")
+
+	position := 1
+	id, err := f.service.Create(withUser("alice"), models.CreateRequest{ContentId: contentId, CategoryId: categoryId, Position: &position})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := f.repo.order(), []string{"a", id, "b"}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("order = %v, want %v", got, want)
+	}
+
+	_, err = f.service.Create(withUser("alice"), models.CreateRequest{ContentId: contentId, CategoryId: "64b0000000000000000000ff"})
+	if !errors.Is(err, errors.ErrInvalidCategory) {
+		t.Fatalf("err = %v, want %s", err, errors.ErrInvalidCategory.Code)
+	}
+}
+
+func TestPositionsAfterDeleteAndReorder(t *testing.T) {
+	f := newFixture("a", "b", "c", "d")
+	ctx := withUser("alice")
+
+	if err := f.service.Delete(ctx, models.IdRequest{ID: "b"}); err != nil {
+		t.Fatal(err)
+	}
+	if got, want := f.repo.order(), []string{"a", "c", "d"}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("order after delete = %v, want %v", got, want)
+	}
+
+	if err := f.service.Reorder(ctx, models.ReorderRequest{ContentId: contentId, PostIds: []string{"d", "a", "c"}}); err != nil {
+		t.Fatal(err)
+	}
+	appended, err := f.service.Create(ctx, models.CreateRequest{ContentId: contentId, CategoryId: categoryId})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := f.repo.order(), []string{"d", "a", "c", appended}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("order after reorder and append = %v, want %v", got, want)
+	}
+
+	if err := f.service.Delete(ctx, models.IdRequest{ID: "d"}); err != nil {
+		t.Fatal(err)
+	}
+	position := 0
+	first, err := f.service.Create(ctx, models.CreateRequest{ContentId: contentId, CategoryId: categoryId, Position: &position})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := f.repo.order(), []string{first, "a", "c", appended}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("order after delete and insert = %v, want %v", got, want)
+	}
+}
+
+type fixture struct {
+	service Service
+	repo    *fakeRepository
+	history *fakeHistoryService
+}
+
+func newFixture(postIds ...string) fixture {
+	repo := &fakeRepository{posts: map[string]*models.Post{}}
+	for position, id := range postIds {
+		repo.posts[id] = &models.Post{ID: id, ContentId: contentId, Position: position}
+	}
+	history := &fakeHistoryService{}
+	return fixture{
+		service: NewPolicyService(New(repo, fakeContentService{}, fakeCategoryService{}, history), fakeContentService{}),
+		repo:    repo,
+		history: history,
+	}
+}
+
+func withUser(userId string, groups ...interface{}) context.Context {
+	return context.WithValue(context.Background(), kitJwt.JWTClaimsContextKey, jwt.MapClaims{"sub": userId, "groups": groups})
+}
+
+// fakeRepository keeps the positions of the posts as the repository does.
+type fakeRepository struct {
+	posts    map[string]*models.Post
+	inserted int
+}
+
+func (r *fakeRepository) order() []string {
+	order := make([]string, len(r.posts))
+	for _, postObj := range r.posts {
+		order[postObj.Position] = postObj.ID
+	}
+	return order
+}
+
+func (r *fakeRepository) Insert(_ context.Context, postObj models.Post, position *int) (string, error) {
+	r.inserted++
+	postObj.ID = "inserted-" + strconv.Itoa(r.inserted)
+	postObj.Position = len(r.posts)
+	if position != nil && *position >= 0 && *position < len(r.posts) {
+		postObj.Position = *position
+	}
+	for _, other := range r.posts {
+		if other.Position >= postObj.Position {
+			other.Position++
+		}
+	}
+	r.posts[postObj.ID] = &postObj
+	return postObj.ID, nil
+}
+
+func (r *fakeRepository) Reorder(_ context.Context, _ string, postIds []string) error {
+	if len(postIds) != len(r.posts) {
+		return errors.ErrInconsistentIDs
+	}
+	for _, id := range postIds {
+		if _, ok := r.posts[id]; !ok {
+			return errors.ErrInconsistentIDs
+		}
+	}
+	for position, id := range postIds {
+		r.posts[id].Position = position
+	}
+	return nil
+}
+
+func (r *fakeRepository) FindByID(_ context.Context, id string) (*models.Post, error) {
+	postObj, ok := r.posts[id]
+	if !ok {
+		return nil, errors.ErrNotFound
+	}
+	return postObj, nil
+}
+
+func (r *fakeRepository) Update(_ context.Context, updateObj models.UpdateRequest) error {
+	postObj, ok := r.posts[updateObj.ID]
+	if !ok {
+		return errors.ErrNotFound
+	}
+	postObj.UpdatedBy = updateObj.UpdatedBy
+	return nil
+}
+
+func (r *fakeRepository) DeleteByID(_ context.Context, id string) error {
+	postObj, ok := r.posts[id]
+	if !ok {
+		return errors.ErrNotFound
+	}
+	delete(r.posts, id)
+	for _, other := range r.posts {
+		if other.Position > postObj.Position {
+			other.Position--
+		}
+	}
+	return nil
+}
+
+func (r *fakeRepository) Count(context.Context, models.PostFilter) (int64, error) {
+	return int64(len(r.posts)), nil
+}
+
+func (r *fakeRepository) CountByCategory(context.Context, models.PostFilter) (map[string]int64, error) {
+	return nil, nil
+}
+
+func (r *fakeRepository) GetAll(context.Context, int64, int64, models.PostFilter) ([]*models.Post, error) {
+	return nil, nil
+}
+
+// fakeContentService knows a single content, written by alice and
+// translated by tom.
+type fakeContentService struct {
+	content.Service
+}
+
+func (fakeContentService) GetOne(_ context.Context, data contentModel.IdRequest) (*contentModel.Content, error) {
+	if data.ID != contentId {
+		return nil, errors.ErrNotFound
+	}
+	return &contentModel.Content{
+		ID:           contentId,
+		AuthorId:     "alice",
+		Contributors: []*contentModel.Contributor{{UserId: "alice", Role: contentModel.RoleAuthor}, {UserId: "tom", Role: contentModel.RoleTranslator}},
+	}, nil
+}
+
+// fakeCategoryService knows a single category.
+type fakeCategoryService struct {
+	postCategory.Service
+}
+
+func (fakeCategoryService) GetOne(_ context.Context, data postCategoryModel.IdRequest) (*postCategoryModel.PostCategory, error) {
+	if data.ID != categoryId {
+		return nil, errors.ErrNotFound
+	}
+	return &postCategoryModel.PostCategory{ID: categoryId, Name: "news"}, nil
+}
+
+type fakeHistoryService struct {
+	entries []contentHistoryModel.ContentHistory
+}
+
+func (s *fakeHistoryService) Create(_ context.Context, data contentHistoryModel.ContentHistory) error {
+	s.entries = append(s.entries, data)
+	return nil
+}
+
+func (s *fakeHistoryService) GetList(context.Context, contentHistoryModel.GetListRequest) ([]*contentHistoryModel.ContentHistory, contentHistoryModel.Pagination, error) {
+	return nil, contentHistoryModel.Pagination{}, nil
+}
@@ -0,0 +1,150 @@
+package transports
+
+import (
+	"context"
+	"github.com/SpawNKZ/content_service/common/transport"
+	"github.com/SpawNKZ/content_service/common/validation"
+	"github.com/SpawNKZ/content_service/pb"
+	"github.com/SpawNKZ/content_service/post/endpoints"
+	"github.com/SpawNKZ/content_service/post/models"
+	postService "github.com/SpawNKZ/content_service/post/service"
+	"github.com/go-kit/kit/endpoint"
+	grpcTransport "github.com/go-kit/kit/transport/grpc"
+	"github.com/go-kit/log"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+type grpcServer struct {
+	pb.UnimplementedPostServiceServer
+	create  grpcTransport.Handler
+	getOne  grpcTransport.Handler
+	update  grpcTransport.Handler
+	delete  grpcTransport.Handler
+	getList grpcTransport.Handler
+}
+
+// NewGRPCServer serves the post endpoints over gRPC, guarded by the same
+// middlewares as over HTTP.
+func NewGRPCServer(s postService.Service, authMiddleware endpoint.Middleware, logger log.Logger) pb.PostServiceServer {
+	options := transport.GRPCServerOptions(logger)
+	validate := validation.Middleware()
+
+	return &grpcServer{
+		create: grpcTransport.NewServer(
+			authMiddleware(validate(endpoints.MakeCreateEndpoint(s))),
+			decodeGRPCCreateRequest,
+			transport.EncodeGRPCResponse,
+			options...,
+		),
+		getOne: grpcTransport.NewServer(
+			authMiddleware(endpoints.MakeGetOneEndpoint(s)),
+			decodeGRPCIdRequest,
+			transport.EncodeGRPCResponse,
+			options...,
+		),
+		update: grpcTransport.NewServer(
+			authMiddleware(validate(endpoints.MakeUpdateEndpoint(s))),
+			decodeGRPCUpdateRequest,
+			transport.EncodeGRPCResponse,
+			options...,
+		),
+		delete: grpcTransport.NewServer(
+			authMiddleware(endpoints.MakeDeleteOneEndpoint(s)),
+			decodeGRPCIdRequest,
+			transport.EncodeGRPCResponse,
+			options...,
+		),
+		getList: grpcTransport.NewServer(
+			authMiddleware(endpoints.MakeGetListEndpoint(s)),
+			decodeGRPCGetListRequest,
+			transport.EncodeGRPCResponse,
+			options...,
+		),
+	}
+}
+
+func (g *grpcServer) Create(ctx context.Context, req *pb.CreatePostRequest) (*pb.Created, error) {
+	ctx, res, err := g.create.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, transport.GRPCError(ctx, err)
+	}
+	return res.(*pb.Created), nil
+}
+
+func (g *grpcServer) Get(ctx context.Context, req *pb.IdRequest) (*pb.Post, error) {
+	ctx, res, err := g.getOne.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, transport.GRPCError(ctx, err)
+	}
+	return res.(*pb.Post), nil
+}
+
+func (g *grpcServer) Update(ctx context.Context, req *pb.UpdatePostRequest) (*emptypb.Empty, error) {
+	ctx, res, err := g.update.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, transport.GRPCError(ctx, err)
+	}
+	return res.(*emptypb.Empty), nil
+}
+
+func (g *grpcServer) Delete(ctx context.Context, req *pb.IdRequest) (*emptypb.Empty, error) {
+	ctx, res, err := g.delete.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, transport.GRPCError(ctx, err)
+	}
+	return res.(*emptypb.Empty), nil
+}
+
+func (g *grpcServer) List(ctx context.Context, req *pb.ListPostRequest) (*pb.PostList, error) {
+	ctx, res, err := g.getList.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, transport.GRPCError(ctx, err)
+	}
+	return res.(*pb.PostList), nil
+}
+
+func decodeGRPCCreateRequest(_ context.Context, grpcReq interface{}) (interface{}, error) {
+	req := grpcReq.(*pb.CreatePostRequest)
+
+	var position *int
+	if req.Position != nil {
+		p := int(*req.Position)
+		position = &p
+	}
+
+	return models.CreateRequest{
+		CategoryId:  req.CategoryId,
+		Resources:   req.Resources,
+		ContentId:   req.ContentId,
+		Description: req.Description,
+		Position:    position,
+	}, nil
+}
+
+func decodeGRPCIdRequest(_ context.Context, grpcReq interface{}) (interface{}, error) {
+	req := grpcReq.(*pb.IdRequest)
+	return models.IdRequest{ID: req.Id}, nil
+}
+
+func decodeGRPCUpdateRequest(_ context.Context, grpcReq interface{}) (interface{}, error) {
+	req := grpcReq.(*pb.UpdatePostRequest)
+	return models.UpdateRequest{
+		ID:          req.Id,
+		CategoryId:  req.CategoryId,
+		Description: req.Description,
+		Resources:   req.Resources,
+	}, nil
+}
+
+func decodeGRPCGetListRequest(_ context.Context, grpcReq interface{}) (interface{}, error) {
+	req := grpcReq.(*pb.ListPostRequest)
+	return models.GetListRequest{
+		ReqPagination: models.ReqPagination{
+			Limit: int(req.Limit), Offset: int(req.Offset),
+		},
+		PostFilter: models.PostFilter{
+			ContentId:  req.ContentId,
+			CategoryId: req.CategoryId,
+		},
+	}, nil
+}
@@ -0,0 +1,25 @@
+package transports
+
+import (
+	"github.com/SpawNKZ/content_service/common/openapi"
+	"github.com/SpawNKZ/content_service/post/models"
+)
+
+// OpenAPIOperations describes the routes registered by MakeHTTPHandler.
+func OpenAPIOperations() []openapi.Operation {
+	const tag = "post"
+	id := openapi.PathParam("id")
+	return []openapi.Operation{
+		{Method: "POST", Path: "/api/v1/post", Tag: tag, Summary: "Create a post", Request: models.CreateRequest{}, Response: models.CreateResponse{}, Secured: true},
+		{Method: "GET", Path: "/api/v1/post/{id}", Tag: tag, Summary: "Get a post by ID", Params: []openapi.Param{id}, Response: models.GetOneResponse{}, Secured: true},
+		{Method: "PUT", Path: "/api/v1/post/reorder", Tag: tag, Summary: "Reorder the posts of content", Request: models.ReorderRequest{}, Response: models.UpdateResponse{}, Secured: true},
+		{Method: "PUT", Path: "/api/v1/post/{id}", Tag: tag, Summary: "Update a post", Params: []openapi.Param{id}, Request: models.UpdateRequest{}, Response: models.UpdateResponse{}, Secured: true},
+		{Method: "DELETE", Path: "/api/v1/post/{id}", Tag: tag, Summary: "Delete a post", Params: []openapi.Param{id}, Response: models.DeleteOneResponse{}, Secured: true},
+		{Method: "GET", Path: "/api/v1/post", Tag: tag, Summary: "List posts by position",
+			Params: append([]openapi.Param{
+				openapi.QueryParam("content_id", "string", ""),
+				openapi.QueryParam("category_id", "string", "Category ID."),
+			}, openapi.PaginationParams()...),
+			Response: models.GetListResponse{}, Secured: true},
+	}
+}
@@ -0,0 +1,16 @@
+package models
+
+import (
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+type PostCategoryModel struct {
+	ID           primitive.ObjectID `bson:"_id"`
+	Name         string             `bson:"name"`
+	Translations []TranslationModel `bson:"translations"`
+}
+
+type TranslationModel struct {
+	Locale string `bson:"locale"`
+	Name   string `bson:"name"`
+}
@@ -0,0 +1,66 @@
+package models
+
+import (
+	contentModel "github.com/SpawNKZ/content_service/content/models"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+func (pc *PostCategory) ToRepositoryModel() *PostCategoryModel {
+	return &PostCategoryModel{
+		ID:           primitive.NewObjectID(),
+		Name:         pc.Name,
+		Translations: toTranslationModels(pc.Translations),
+	}
+}
+
+func (pc *PostCategory) FromRepositoryModel(repoPc *PostCategoryModel) {
+	pc.ID = repoPc.ID.Hex()
+	pc.Name = repoPc.Name
+	pc.Translations = make([]*contentModel.Translation, 0, len(repoPc.Translations))
+	for _, translation := range repoPc.Translations {
+		pc.Translations = append(pc.Translations, &contentModel.Translation{
+			Locale: translation.Locale,
+			Name:   translation.Name,
+		})
+	}
+}
+
+func (cr *CreateRequest) ToPostCategory() *PostCategory {
+	return &PostCategory{
+		Name:         cr.Name,
+		Translations: translationRefs(cr.Translations),
+	}
+}
+
+func (ur *UpdateRequest) ToPostCategory() map[string]interface{} {
+	args := make(map[string]interface{})
+
+	if ur.Name != "" {
+		args["name"] = ur.Name
+	}
+
+	if len(ur.Translations) > 0 {
+		args["translations"] = toTranslationModels(translationRefs(ur.Translations))
+	}
+
+	return args
+}
+
+func translationRefs(translations []contentModel.Translation) []*contentModel.Translation {
+	refs := make([]*contentModel.Translation, 0, len(translations))
+	for i := range translations {
+		refs = append(refs, &translations[i])
+	}
+	return refs
+}
+
+func toTranslationModels(translations []*contentModel.Translation) []TranslationModel {
+	models := make([]TranslationModel, 0, len(translations))
+	for _, translation := range translations {
+		models = append(models, TranslationModel{
+			Locale: translation.Locale,
+			Name:   translation.Name,
+		})
+	}
+	return models
+}
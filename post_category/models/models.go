@@ -0,0 +1,11 @@
+package models
+
+import (
+	contentModel "github.com/SpawNKZ/content_service/content/models"
+)
+
+type PostCategory struct {
+	ID           string                      `json:"id"`
+	Name         string                      `json:"name"`
+	Translations []*contentModel.Translation `json:"translations"`
+}
@@ -0,0 +1,29 @@
+package models
+
+import (
+	contentModel "github.com/SpawNKZ/content_service/content/models"
+)
+
+type CreateRequest struct {
+	Name         string                     `json:"name" validate:"required,max=100"`
+	Translations []contentModel.Translation `json:"translations" validate:"unique=Locale,dive"`
+}
+
+type IdRequest struct {
+	ID string
+}
+
+type UpdateRequest struct {
+	ID           string
+	Name         string                     `json:"name" validate:"max=100"`
+	Translations []contentModel.Translation `json:"translations" validate:"omitempty,unique=Locale,dive"`
+}
+
+type ReqPagination struct {
+	Limit  int
+	Offset int
+}
+
+type GetListRequest struct {
+	ReqPagination
+}
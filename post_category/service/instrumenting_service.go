@@ -0,0 +1,68 @@
+package service
+
+import (
+	"context"
+	"github.com/SpawNKZ/content_service/common/instrumenting"
+	"github.com/SpawNKZ/content_service/post_category/models"
+	"time"
+)
+
+type instrumentingService struct {
+	metrics instrumenting.RequestMetrics
+	service Service
+}
+
+func NewInstrumentingService(metrics instrumenting.RequestMetrics, service Service) Service {
+	return &instrumentingService{
+		metrics: metrics,
+		service: service,
+	}
+}
+
+func (s *instrumentingService) Create(ctx context.Context, request models.CreateRequest) (result string, err error) {
+	defer func(begin time.Time) {
+		s.metrics.Observe("Create", begin, err)
+	}(time.Now())
+
+	return s.service.Create(ctx, request)
+}
+
+func (s *instrumentingService) GetOne(ctx context.Context, request models.IdRequest) (result *models.PostCategory, err error) {
+	defer func(begin time.Time) {
+		s.metrics.Observe("GetOne", begin, err)
+	}(time.Now())
+
+	return s.service.GetOne(ctx, request)
+}
+
+func (s *instrumentingService) GetList(ctx context.Context, request models.GetListRequest) (result []*models.PostCategory, pagination models.Pagination, err error) {
+	defer func(begin time.Time) {
+		s.metrics.Observe("GetList", begin, err)
+	}(time.Now())
+
+	return s.service.GetList(ctx, request)
+}
+
+func (s *instrumentingService) Update(ctx context.Context, request models.UpdateRequest) (err error) {
+	defer func(begin time.Time) {
+		s.metrics.Observe("Update", begin, err)
+	}(time.Now())
+
+	return s.service.Update(ctx, request)
+}
+
+func (s *instrumentingService) Delete(ctx context.Context, request models.IdRequest) (err error) {
+	defer func(begin time.Time) {
+		s.metrics.Observe("Delete", begin, err)
+	}(time.Now())
+
+	return s.service.Delete(ctx, request)
+}
+
+func (s *instrumentingService) GetByName(ctx context.Context, name string) (result *models.PostCategory, err error) {
+	defer func(begin time.Time) {
+		s.metrics.Observe("GetByName", begin, err)
+	}(time.Now())
+
+	return s.service.GetByName(ctx, name)
+}
@@ -0,0 +1,122 @@
+package service
+
+import (
+	"context"
+	"github.com/SpawNKZ/content_service/common/errors"
+	"github.com/SpawNKZ/content_service/common/pagination"
+	"github.com/SpawNKZ/content_service/post_category/models"
+	repository "github.com/SpawNKZ/content_service/post_category/repo"
+)
+
+type Service interface {
+	Create(ctx context.Context, data models.CreateRequest) (string, error)
+	GetOne(ctx context.Context, data models.IdRequest) (*models.PostCategory, error)
+	GetByName(ctx context.Context, name string) (*models.PostCategory, error)
+	Update(ctx context.Context, data models.UpdateRequest) error
+	Delete(ctx context.Context, data models.IdRequest) error
+	GetList(ctx context.Context, data models.GetListRequest) ([]*models.PostCategory, models.Pagination, error)
+}
+
+// PostUsage counts the posts using a category. It is implemented by the post
+// package, which depends on this one.
+type PostUsage interface {
+	CountByCategory(ctx context.Context, categoryId string) (int64, error)
+}
+
+type service struct {
+	repo  repository.Repository
+	usage PostUsage
+}
+
+func New(repo repository.Repository, usage PostUsage) Service {
+	return &service{
+		repo:  repo,
+		usage: usage,
+	}
+}
+
+func (s *service) Create(ctx context.Context, data models.CreateRequest) (string, error) {
+	err := s.ensureUniqueName(ctx, "", data.Name)
+	if err != nil {
+		return "", err
+	}
+
+	return s.repo.Insert(ctx, *data.ToPostCategory())
+}
+
+func (s *service) GetOne(ctx context.Context, data models.IdRequest) (*models.PostCategory, error) {
+	return s.repo.FindByID(ctx, data.ID)
+}
+
+func (s *service) GetByName(ctx context.Context, name string) (*models.PostCategory, error) {
+	return s.repo.FindByName(ctx, name)
+}
+
+func (s *service) GetList(ctx context.Context, data models.GetListRequest) ([]*models.PostCategory, models.Pagination, error) {
+	limit, offset, err := pagination.Normalize(data.Limit, data.Offset)
+	if err != nil {
+		return nil, models.Pagination{}, err
+	}
+	data.Limit, data.Offset = limit, offset
+
+	count, err := s.repo.Count(ctx)
+
+	if err != nil {
+		return nil, models.Pagination{}, errors.ErrDB
+	}
+
+	postCategoryList, err := s.repo.GetAll(ctx, int64(data.Limit), int64(data.Offset))
+	if err != nil {
+		return nil, models.Pagination{}, errors.ErrDB
+	}
+
+	hasNextPage := int64(data.Offset+data.Limit) < count
+
+	return postCategoryList, models.Pagination{
+		Total:      int(count),
+		Limit:      data.Limit,
+		Offset:     data.Offset,
+		IsLastPage: !hasNextPage,
+	}, nil
+}
+
+func (s *service) Update(ctx context.Context, data models.UpdateRequest) error {
+	if data.Name != "" {
+		err := s.ensureUniqueName(ctx, data.ID, data.Name)
+		if err != nil {
+			return err
+		}
+	}
+
+	return s.repo.Update(ctx, data)
+}
+
+func (s *service) Delete(ctx context.Context, data models.IdRequest) error {
+	_, err := s.repo.FindByID(ctx, data.ID)
+	if err != nil {
+		return err
+	}
+
+	count, err := s.usage.CountByCategory(ctx, data.ID)
+	if err != nil {
+		return err
+	}
+	if count > 0 {
+		return errors.ErrCategoryInUse.WithDetails(map[string]interface{}{"post_count": count})
+	}
+
+	return s.repo.DeleteByID(ctx, data.ID)
+}
+
+func (s *service) ensureUniqueName(ctx context.Context, id string, name string) error {
+	existing, err := s.repo.FindByName(ctx, name)
+	switch {
+	case errors.Is(err, errors.ErrNotFound):
+		return nil
+	case err != nil:
+		return err
+	case existing.ID != id:
+		return errors.ErrAlreadyExists
+	}
+	return nil
+}
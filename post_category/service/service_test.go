@@ -0,0 +1,151 @@
+package service
+
+import (
+	"context"
+	stdErrors "errors"
+	"github.com/SpawNKZ/content_service/common/errors"
+	"github.com/SpawNKZ/content_service/common/validation"
+	contentModel "github.com/SpawNKZ/content_service/content/models"
+	"github.com/SpawNKZ/content_service/post_category/models"
+	"reflect"
+	"testing"
+)
+
+func TestRequestsValidateTranslations(t *testing.T) {
+	tests := []struct {
+		name       string
+		request    interface{}
+		wantFields []errors.FieldError
+	}{
+		{
+			name:    "create",
+			request: models.CreateRequest{Name: "news", Translations: []contentModel.Translation{{Locale: "ru", Name: "новости"}, {Locale: "kk", Name: "жаңалықтар"}}},
+		},
+		{
+			name:       "create without name",
+			request:    models.CreateRequest{},
+			wantFields: []errors.FieldError{{Field: "name", Message: "is required"}},
+		},
+		{
+			name:       "duplicate locale",
+			request:    models.CreateRequest{Name: "news", Translations: []contentModel.Translation{{Locale: "ru", Name: "новости"}, {Locale: "ru", Name: "вести"}}},
+			wantFields: []errors.FieldError{{Field: "translations", Message: "should not contain duplicates"}},
+		},
+		{
+			name:    "incomplete translation",
+			request: models.UpdateRequest{Translations: []contentModel.Translation{{Locale: "de"}}},
+			wantFields: []errors.FieldError{
+				{Field: "translations[0].locale", Message: "should be one of: kk, ru, en"},
+				{Field: "translations[0].name", Message: "is required"},
+			},
+		},
+		{
+			name:    "update without translations",
+			request: models.UpdateRequest{Name: "news"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validation.Validate(tt.request)
+			if tt.wantFields == nil {
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				return
+			}
+			var appErr *errors.AppError
+			if !stdErrors.As(err, &appErr) || !errors.Is(err, errors.ErrValidation) || !reflect.DeepEqual(appErr.Fields, tt.wantFields) {
+				t.Fatalf("err = %v, want %s with the fields %+v", err, errors.ErrValidation.Code, tt.wantFields)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	tests := []struct {
+		name   string
+		counts fakeUsage
+		want   *errors.AppError
+	}{
+		{"unused category", fakeUsage{}, nil},
+		{"category in use", fakeUsage{"news": 2}, errors.ErrCategoryInUse},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := newFakeRepository()
+			err := New(repo, tt.counts).Delete(context.Background(), models.IdRequest{ID: "news"})
+
+			if tt.want == nil {
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				if _, ok := repo.categories["news"]; ok {
+					t.Fatal("category was not deleted")
+				}
+				return
+			}
+			if !errors.Is(err, tt.want) {
+				t.Fatalf("err = %v, want %s", err, tt.want.Code)
+			}
+			if _, ok := repo.categories["news"]; !ok {
+				t.Fatal("category in use was deleted")
+			}
+		})
+	}
+}
+
+// fakeUsage counts posts per category ID.
+type fakeUsage map[string]int64
+
+func (u fakeUsage) CountByCategory(_ context.Context, categoryId string) (int64, error) {
+	return u[categoryId], nil
+}
+
+type fakeRepository struct {
+	categories map[string]*models.PostCategory
+}
+
+func newFakeRepository() *fakeRepository {
+	return &fakeRepository{categories: map[string]*models.PostCategory{
+		"news": {ID: "news", Name: "news"},
+	}}
+}
+
+func (r *fakeRepository) Insert(_ context.Context, postCategoryObj models.PostCategory) (string, error) {
+	r.categories[postCategoryObj.Name] = &postCategoryObj
+	return postCategoryObj.Name, nil
+}
+
+func (r *fakeRepository) FindByID(_ context.Context, id string) (*models.PostCategory, error) {
+	postCategoryObj, ok := r.categories[id]
+	if !ok {
+		return nil, errors.ErrNotFound
+	}
+	return postCategoryObj, nil
+}
+
+func (r *fakeRepository) FindByName(_ context.Context, name string) (*models.PostCategory, error) {
+	for _, postCategoryObj := range r.categories {
+		if postCategoryObj.Name == name {
+			return postCategoryObj, nil
+		}
+	}
+	return nil, errors.ErrNotFound
+}
+
+func (r *fakeRepository) Update(context.Context, models.UpdateRequest) error {
+	return nil
+}
+
+func (r *fakeRepository) DeleteByID(_ context.Context, id string) error {
+	delete(r.categories, id)
+	return nil
+}
+
+func (r *fakeRepository) Count(context.Context) (int64, error) {
+	return int64(len(r.categories)), nil
+}
+
+func (r *fakeRepository) GetAll(context.Context, int64, int64) ([]*models.PostCategory, error) {
+	return nil, nil
+}
@@ -0,0 +1,118 @@
+package transports
+
+import (
+	"context"
+	"github.com/SpawNKZ/content_service/common/auth"
+	"github.com/SpawNKZ/content_service/common/transport"
+	"github.com/SpawNKZ/content_service/common/validation"
+	"github.com/SpawNKZ/content_service/post_category/endpoints"
+	"github.com/SpawNKZ/content_service/post_category/models"
+	postCategoryService "github.com/SpawNKZ/content_service/post_category/service"
+	"github.com/go-kit/kit/endpoint"
+	httpTransport "github.com/go-kit/kit/transport/http"
+	"github.com/go-kit/log"
+	"github.com/gorilla/mux"
+	"net/http"
+)
+
+var requiredGroups = []string{"admin"}
+
+// MakeHTTPHandler serves the post categories to everyone, only admins may
+// create, update or delete them.
+func MakeHTTPHandler(s postCategoryService.Service, authMiddleware endpoint.Middleware, logger log.Logger) http.Handler {
+	r := mux.NewRouter()
+	options := transport.ServerOptions(logger)
+	validate := validation.Middleware()
+	requireGroups := auth.RequireGroups(requiredGroups...)
+	// GET     /post_category                      retrieve post categories with all translations
+	// GET     /post_category/:id                  retrieve post category by id
+	// POST    /post_category                      create post category
+	// PUT     /post_category/:id                  update the post category
+	// DELETE  /post_category/:id                  remove the given post category, unless posts use it
+	sr := r.PathPrefix("/api/v1/post_category").Subrouter()
+
+	sr.Methods("POST").Path("").Handler(httpTransport.NewServer(
+		authMiddleware(requireGroups(validate(endpoints.MakeCreateEndpoint(s)))),
+		decodeCreateRequest,
+		transport.EncodeResponse,
+		options...,
+	))
+	sr.Methods("GET").Path("/{id}").Handler(httpTransport.NewServer(
+		authMiddleware(endpoints.MakeGetOneEndpoint(s)),
+		decodeGetOneRequest,
+		transport.EncodeResponse,
+		options...,
+	))
+	sr.Methods("PUT").Path("/{id}").Handler(httpTransport.NewServer(
+		authMiddleware(requireGroups(validate(endpoints.MakeUpdateEndpoint(s)))),
+		decodeUpdateRequest,
+		transport.EncodeResponse,
+		options...,
+	))
+	sr.Methods("DELETE").Path("/{id}").Handler(httpTransport.NewServer(
+		authMiddleware(requireGroups(endpoints.MakeDeleteOneEndpoint(s))),
+		decodeDeleteOneRequest,
+		transport.EncodeResponse,
+		options...,
+	))
+	sr.Methods("GET").Path("").Handler(httpTransport.NewServer(
+		authMiddleware(endpoints.MakeGetListEndpoint(s)),
+		decodeGetListRequest,
+		transport.EncodeResponse,
+		options...,
+	))
+	return r
+}
+
+func decodeCreateRequest(_ context.Context, r *http.Request) (request interface{}, err error) {
+	var req models.CreateRequest
+	if err := transport.DecodeJSON(r, &req); err != nil {
+		return nil, err
+	}
+	return req, nil
+}
+
+func decodeGetOneRequest(_ context.Context, r *http.Request) (request interface{}, err error) {
+	id, err := transport.PathVar(r, "id")
+	if err != nil {
+		return nil, err
+	}
+	return models.IdRequest{ID: id}, nil
+}
+
+func decodeUpdateRequest(_ context.Context, r *http.Request) (request interface{}, err error) {
+	id, err := transport.PathVar(r, "id")
+	if err != nil {
+		return nil, err
+	}
+	var postCategoryUpdate models.UpdateRequest
+	if err := transport.DecodeJSON(r, &postCategoryUpdate); err != nil {
+		return nil, err
+	}
+
+	postCategoryUpdate.ID = id
+
+	return postCategoryUpdate, nil
+}
+
+func decodeDeleteOneRequest(_ context.Context, r *http.Request) (request interface{}, err error) {
+	id, err := transport.PathVar(r, "id")
+	if err != nil {
+		return nil, err
+	}
+
+	return models.IdRequest{ID: id}, nil
+}
+
+func decodeGetListRequest(_ context.Context, r *http.Request) (request interface{}, err error) {
+	limit, offset, err := transport.Pagination(r)
+	if err != nil {
+		return nil, err
+	}
+
+	return models.GetListRequest{
+		ReqPagination: models.ReqPagination{
+			Limit: limit, Offset: offset,
+		},
+	}, nil
+}
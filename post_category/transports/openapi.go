@@ -0,0 +1,19 @@
+package transports
+
+import (
+	"github.com/SpawNKZ/content_service/common/openapi"
+	"github.com/SpawNKZ/content_service/post_category/models"
+)
+
+// OpenAPIOperations describes the routes registered by MakeHTTPHandler.
+func OpenAPIOperations() []openapi.Operation {
+	const tag = "post_category"
+	id := openapi.PathParam("id")
+	return []openapi.Operation{
+		{Method: "POST", Path: "/api/v1/post_category", Tag: tag, Summary: "Create a post category", Request: models.CreateRequest{}, Response: models.CreateResponse{}, Secured: true},
+		{Method: "GET", Path: "/api/v1/post_category/{id}", Tag: tag, Summary: "Get a post category by ID", Params: []openapi.Param{id}, Response: models.GetOneResponse{}},
+		{Method: "PUT", Path: "/api/v1/post_category/{id}", Tag: tag, Summary: "Update a post category", Params: []openapi.Param{id}, Request: models.UpdateRequest{}, Response: models.UpdateResponse{}, Secured: true},
+		{Method: "DELETE", Path: "/api/v1/post_category/{id}", Tag: tag, Summary: "Delete a post category", Params: []openapi.Param{id}, Response: models.DeleteOneResponse{}, Secured: true},
+		{Method: "GET", Path: "/api/v1/post_category", Tag: tag, Summary: "List post categories", Params: openapi.PaginationParams(), Response: models.GetListResponse{}},
+	}
+}
@@ -0,0 +1,56 @@
+package endpoints
+
+import (
+	"context"
+	"github.com/SpawNKZ/content_service/review/models"
+	reviewService "github.com/SpawNKZ/content_service/review/service"
+	"github.com/go-kit/kit/endpoint"
+)
+
+func MakeCreateEndpoint(s reviewService.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (response interface{}, err error) {
+		req := request.(models.CreateRequest)
+		id, err := s.Create(ctx, req)
+		return models.CreateResponse{ID: id, Err: err}, nil
+	}
+}
+
+func MakeGetOneEndpoint(s reviewService.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (response interface{}, err error) {
+		req := request.(models.IdRequest)
+		res, e := s.GetOne(ctx, req)
+		return models.GetOneResponse{Review: res, Err: e}, nil
+	}
+}
+
+func MakeGetListEndpoint(s reviewService.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (response interface{}, err error) {
+		req := request.(models.GetListRequest)
+		res, pagination, e := s.GetList(ctx, req)
+		return models.GetListResponse{ReviewList: res, Pagination: pagination, Err: e}, nil
+	}
+}
+
+func MakeCommentEndpoint(s reviewService.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (response interface{}, err error) {
+		req := request.(models.CommentRequest)
+		e := s.Comment(ctx, req)
+		return models.UpdateResponse{Err: e}, nil
+	}
+}
+
+func MakeApproveEndpoint(s reviewService.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (response interface{}, err error) {
+		req := request.(models.DecisionRequest)
+		e := s.Approve(ctx, req)
+		return models.UpdateResponse{Err: e}, nil
+	}
+}
+
+func MakeRequestChangesEndpoint(s reviewService.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (response interface{}, err error) {
+		req := request.(models.DecisionRequest)
+		e := s.RequestChanges(ctx, req)
+		return models.UpdateResponse{Err: e}, nil
+	}
+}
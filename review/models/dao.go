@@ -0,0 +1,26 @@
+package models
+
+import (
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"time"
+)
+
+type ReviewModel struct {
+	ID          primitive.ObjectID `bson:"_id"`
+	ContentId   string             `bson:"content_id"`
+	RequesterId string             `bson:"requester_id"`
+	ReviewerId  string             `bson:"reviewer_id"`
+	State       string             `bson:"state"`
+	Comments    []CommentModel     `bson:"comments"`
+	CreatedAt   time.Time          `bson:"created_at"`
+	UpdatedAt   time.Time          `bson:"updated_at"`
+}
+
+type CommentModel struct {
+	ID         primitive.ObjectID `bson:"_id"`
+	AuthorId   string             `bson:"author_id"`
+	Body       string             `bson:"body"`
+	RangeStart int                `bson:"range_start"`
+	RangeEnd   int                `bson:"range_end"`
+	CreatedAt  time.Time          `bson:"created_at"`
+}
@@ -0,0 +1,71 @@
+package models
+
+import (
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"time"
+)
+
+func (rv *Review) ToRepositoryModel() *ReviewModel {
+	return &ReviewModel{
+		ID:          primitive.NewObjectID(),
+		ContentId:   rv.ContentId,
+		RequesterId: rv.RequesterId,
+		ReviewerId:  rv.ReviewerId,
+		State:       StatePending,
+		Comments:    []CommentModel{},
+		CreatedAt:   time.Now(),
+		UpdatedAt:   time.Now(),
+	}
+}
+
+func (rv *Review) FromRepositoryModel(repoRv *ReviewModel) {
+	rv.ID = repoRv.ID.Hex()
+	rv.ContentId = repoRv.ContentId
+	rv.RequesterId = repoRv.RequesterId
+	rv.ReviewerId = repoRv.ReviewerId
+	rv.State = repoRv.State
+	rv.CreatedAt = repoRv.CreatedAt
+	rv.UpdatedAt = repoRv.UpdatedAt
+
+	rv.Comments = make([]*Comment, 0, len(repoRv.Comments))
+	for i := range repoRv.Comments {
+		var comment Comment
+		comment.FromRepositoryModel(&repoRv.Comments[i])
+		rv.Comments = append(rv.Comments, &comment)
+	}
+}
+
+func (cm *Comment) ToRepositoryModel() *CommentModel {
+	return &CommentModel{
+		ID:         primitive.NewObjectID(),
+		AuthorId:   cm.AuthorId,
+		Body:       cm.Body,
+		RangeStart: cm.RangeStart,
+		RangeEnd:   cm.RangeEnd,
+		CreatedAt:  time.Now(),
+	}
+}
+
+func (cm *Comment) FromRepositoryModel(repoCm *CommentModel) {
+	cm.ID = repoCm.ID.Hex()
+	cm.AuthorId = repoCm.AuthorId
+	cm.Body = repoCm.Body
+	cm.RangeStart = repoCm.RangeStart
+	cm.RangeEnd = repoCm.RangeEnd
+	cm.CreatedAt = repoCm.CreatedAt
+}
+
+func (cr *CreateRequest) ToReview() *Review {
+	return &Review{
+		ContentId:  cr.ContentId,
+		ReviewerId: cr.ReviewerId,
+	}
+}
+
+func (cr *CommentRequest) ToComment() *Comment {
+	return &Comment{
+		Body:       cr.Body,
+		RangeStart: cr.RangeStart,
+		RangeEnd:   cr.RangeEnd,
+	}
+}
@@ -0,0 +1,46 @@
+package models
+
+import (
+	"github.com/SpawNKZ/content_service/common/pagination"
+	"time"
+)
+
+const (
+	StatePending          = "pending"
+	StateApproved         = "approved"
+	StateChangesRequested = "changes_requested"
+)
+
+type Review struct {
+	ID          string     `json:"id"`
+	ContentId   string     `json:"content_id"`
+	RequesterId string     `json:"requester_id"`
+	ReviewerId  string     `json:"reviewer_id"`
+	State       string     `json:"state"`
+	Comments    []*Comment `json:"comments"`
+	CreatedAt   time.Time  `json:"created_at"`
+	UpdatedAt   time.Time  `json:"updated_at"`
+}
+
+// Comment is anchored to the [RangeStart, RangeEnd) range of the content body.
+type Comment struct {
+	ID         string    `json:"id"`
+	AuthorId   string    `json:"author_id"`
+	Body       string    `json:"body"`
+	RangeStart int       `json:"range_start"`
+	RangeEnd   int       `json:"range_end"`
+	CreatedAt  time.Time `json:"created_at"`
+}
+
+type Pagination struct {
+	Total      int  `json:"total"`
+	Limit      int  `json:"limit"`
+	Offset     int  `json:"offset"`
+	IsLastPage bool `json:"isLastPage"`
+}
+
+const (
+	DefaultLimit = pagination.DefaultLimit
+	MaxOffset    = pagination.MaxOffset
+	MaxLimit     = pagination.MaxLimit
+)
@@ -0,0 +1,38 @@
+package models
+
+type CreateRequest struct {
+	ContentId  string `json:"content_id" validate:"required,objectid"`
+	ReviewerId string `json:"reviewer_id" validate:"required,max=64"`
+}
+
+type IdRequest struct {
+	ID string
+}
+
+type CommentRequest struct {
+	ID         string
+	Body       string `json:"body" validate:"required,max=10000"`
+	RangeStart int    `json:"range_start" validate:"min=0"`
+	RangeEnd   int    `json:"range_end" validate:"min=0"`
+}
+
+type DecisionRequest struct {
+	ID      string
+	Comment string `json:"comment" validate:"max=10000"`
+}
+
+type ReqPagination struct {
+	Limit  int
+	Offset int
+}
+
+type ReviewFilter struct {
+	ContentId  string `bson:"content_id"`
+	ReviewerId string `bson:"reviewer_id"`
+	State      string `bson:"state"`
+}
+
+type GetListRequest struct {
+	ReqPagination
+	ReviewFilter
+}
@@ -0,0 +1,170 @@
+package repository
+
+import (
+	"context"
+	"github.com/SpawNKZ/content_service/common/errors"
+	"github.com/SpawNKZ/content_service/review/models"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"reflect"
+	"time"
+)
+
+type repository struct {
+	c *mongo.Collection
+}
+
+type Repository interface {
+	Insert(ctx context.Context, reviewObj models.Review) (string, error)
+	FindByID(ctx context.Context, id string) (*models.Review, error)
+	FindPending(ctx context.Context, contentId string) (*models.Review, error)
+	AddComment(ctx context.Context, id string, commentObj models.Comment) error
+	UpdateState(ctx context.Context, id string, state string) error
+	Count(ctx context.Context, filter models.ReviewFilter) (int64, error)
+	GetAll(ctx context.Context, limit int64, offset int64, filter models.ReviewFilter) ([]*models.Review, error)
+}
+
+func NewRepository(client *mongo.Client) Repository {
+	return &repository{
+		client.Database("core").Collection("review"),
+	}
+}
+
+func (r *repository) Insert(ctx context.Context, reviewObj models.Review) (string, error) {
+	reviewModel := reviewObj.ToRepositoryModel()
+
+	result, err := r.c.InsertOne(ctx, reviewModel)
+	if err != nil {
+		return "", err
+	}
+
+	return result.InsertedID.(primitive.ObjectID).Hex(), err
+}
+
+func (r *repository) FindByID(ctx context.Context, id string) (*models.Review, error) {
+	objectId, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return nil, errors.ErrInvalidID.Wrap(err)
+	}
+
+	return r.findOne(ctx, bson.M{"_id": objectId})
+}
+
+func (r *repository) FindPending(ctx context.Context, contentId string) (*models.Review, error) {
+	return r.findOne(ctx, bson.M{"content_id": contentId, "state": models.StatePending})
+}
+
+func (r *repository) findOne(ctx context.Context, filter bson.M) (*models.Review, error) {
+	var reviewObj models.ReviewModel
+	err := r.c.FindOne(ctx, filter).Decode(&reviewObj)
+
+	switch {
+	case err == nil:
+		var reviewRes models.Review
+		reviewRes.FromRepositoryModel(&reviewObj)
+		return &reviewRes, nil
+	case err == mongo.ErrNoDocuments:
+		return nil, errors.ErrNotFound
+	default:
+		return nil, err
+	}
+}
+
+func (r *repository) AddComment(ctx context.Context, id string, commentObj models.Comment) error {
+	objectId, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return errors.ErrInvalidID.Wrap(err)
+	}
+	filter := bson.M{"_id": objectId}
+
+	update := bson.M{
+		"$push": bson.M{"comments": commentObj.ToRepositoryModel()},
+		"$set":  bson.M{"updated_at": time.Now()},
+	}
+
+	updateResult, err := r.c.UpdateOne(ctx, filter, update)
+	if err != nil {
+		return err
+	}
+	if updateResult.ModifiedCount == 0 {
+		return errors.ErrNotFound
+	}
+	return nil
+}
+
+func (r *repository) UpdateState(ctx context.Context, id string, state string) error {
+	objectId, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return errors.ErrInvalidID.Wrap(err)
+	}
+	filter := bson.M{"_id": objectId, "state": models.StatePending}
+
+	update := bson.M{"$set": bson.M{"state": state, "updated_at": time.Now()}}
+
+	updateResult, err := r.c.UpdateOne(ctx, filter, update)
+	if err != nil {
+		return err
+	}
+	if updateResult.ModifiedCount == 0 {
+		return errors.ErrInvalidState
+	}
+	return nil
+}
+
+func (r *repository) Count(ctx context.Context, filter models.ReviewFilter) (int64, error) {
+	count, err := r.c.CountDocuments(ctx, buildFilter(filter))
+	if err != nil {
+		return 0, err
+	}
+
+	return count, nil
+}
+
+func (r *repository) GetAll(ctx context.Context, limit int64, offset int64, filter models.ReviewFilter) ([]*models.Review, error) {
+	opts := options.Find()
+	opts.SetSkip(offset)
+	opts.SetLimit(limit)
+	opts.SetSort(bson.M{"created_at": -1})
+
+	cursor, err := r.c.Find(ctx, buildFilter(filter), opts)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var reviewResults []*models.Review
+
+	for cursor.Next(ctx) {
+		var review models.ReviewModel
+		if err := cursor.Decode(&review); err != nil {
+			return nil, err
+		}
+		var reviewRes models.Review
+		reviewRes.FromRepositoryModel(&review)
+		reviewResults = append(reviewResults, &reviewRes)
+	}
+
+	if err := cursor.Err(); err != nil {
+		return nil, err
+	}
+
+	return reviewResults, nil
+}
+
+func buildFilter(filter models.ReviewFilter) bson.M {
+	filterQuery := bson.M{}
+	filterValue := reflect.ValueOf(filter)
+	filterType := reflect.TypeOf(filter)
+
+	for i := 0; i < filterValue.NumField(); i++ {
+		field := filterValue.Field(i)
+		if !field.IsZero() {
+			fieldName := filterType.Field(i).Tag.Get("bson")
+			filterQuery[fieldName] = field.Interface()
+		}
+	}
+
+	return filterQuery
+}
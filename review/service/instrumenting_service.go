@@ -0,0 +1,68 @@
+package service
+
+import (
+	"context"
+	"github.com/SpawNKZ/content_service/common/instrumenting"
+	"github.com/SpawNKZ/content_service/review/models"
+	"time"
+)
+
+type instrumentingService struct {
+	metrics instrumenting.RequestMetrics
+	service Service
+}
+
+func NewInstrumentingService(metrics instrumenting.RequestMetrics, service Service) Service {
+	return &instrumentingService{
+		metrics: metrics,
+		service: service,
+	}
+}
+
+func (s *instrumentingService) Create(ctx context.Context, request models.CreateRequest) (result string, err error) {
+	defer func(begin time.Time) {
+		s.metrics.Observe("Create", begin, err)
+	}(time.Now())
+
+	return s.service.Create(ctx, request)
+}
+
+func (s *instrumentingService) GetOne(ctx context.Context, request models.IdRequest) (result *models.Review, err error) {
+	defer func(begin time.Time) {
+		s.metrics.Observe("GetOne", begin, err)
+	}(time.Now())
+
+	return s.service.GetOne(ctx, request)
+}
+
+func (s *instrumentingService) GetList(ctx context.Context, request models.GetListRequest) (result []*models.Review, pagination models.Pagination, err error) {
+	defer func(begin time.Time) {
+		s.metrics.Observe("GetList", begin, err)
+	}(time.Now())
+
+	return s.service.GetList(ctx, request)
+}
+
+func (s *instrumentingService) Comment(ctx context.Context, request models.CommentRequest) (err error) {
+	defer func(begin time.Time) {
+		s.metrics.Observe("Comment", begin, err)
+	}(time.Now())
+
+	return s.service.Comment(ctx, request)
+}
+
+func (s *instrumentingService) Approve(ctx context.Context, request models.DecisionRequest) (err error) {
+	defer func(begin time.Time) {
+		s.metrics.Observe("Approve", begin, err)
+	}(time.Now())
+
+	return s.service.Approve(ctx, request)
+}
+
+func (s *instrumentingService) RequestChanges(ctx context.Context, request models.DecisionRequest) (err error) {
+	defer func(begin time.Time) {
+		s.metrics.Observe("RequestChanges", begin, err)
+	}(time.Now())
+
+	return s.service.RequestChanges(ctx, request)
+}
@@ -0,0 +1,93 @@
+package service
+
+import (
+	"context"
+	"github.com/SpawNKZ/content_service/review/models"
+	"github.com/go-kit/log"
+	"time"
+)
+
+type loggingService struct {
+	logger  log.Logger
+	service Service
+}
+
+func NewLoggingService(logger log.Logger, service Service) Service {
+	return &loggingService{
+		logger:  logger,
+		service: service,
+	}
+}
+
+func (l *loggingService) Create(ctx context.Context, request models.CreateRequest) (result string, err error) {
+	defer func(begin time.Time) {
+		l.logger.Log(
+			"method", "Create",
+			"id", result,
+			"content_id", request.ContentId,
+			"err", err,
+		)
+	}(time.Now())
+
+	return l.service.Create(ctx, request)
+}
+
+func (l *loggingService) GetOne(ctx context.Context, request models.IdRequest) (result *models.Review, err error) {
+	defer func(begin time.Time) {
+		l.logger.Log(
+			"method", "GetOne",
+			"id", request.ID,
+			"err", err,
+		)
+	}(time.Now())
+
+	return l.service.GetOne(ctx, request)
+}
+
+func (l *loggingService) GetList(ctx context.Context, request models.GetListRequest) (result []*models.Review, pagination models.Pagination, err error) {
+	defer func(begin time.Time) {
+		l.logger.Log(
+			"method", "GetList",
+			"review", len(result),
+			"err", err,
+		)
+	}(time.Now())
+
+	return l.service.GetList(ctx, request)
+}
+
+func (l *loggingService) Comment(ctx context.Context, request models.CommentRequest) (err error) {
+	defer func(begin time.Time) {
+		l.logger.Log(
+			"method", "Comment",
+			"id", request.ID,
+			"err", err,
+		)
+	}(time.Now())
+
+	return l.service.Comment(ctx, request)
+}
+
+func (l *loggingService) Approve(ctx context.Context, request models.DecisionRequest) (err error) {
+	defer func(begin time.Time) {
+		l.logger.Log(
+			"method", "Approve",
+			"id", request.ID,
+			"err", err,
+		)
+	}(time.Now())
+
+	return l.service.Approve(ctx, request)
+}
+
+func (l *loggingService) RequestChanges(ctx context.Context, request models.DecisionRequest) (err error) {
+	defer func(begin time.Time) {
+		l.logger.Log(
+			"method", "RequestChanges",
+			"id", request.ID,
+			"err", err,
+		)
+	}(time.Now())
+
+	return l.service.RequestChanges(ctx, request)
+}
@@ -0,0 +1,262 @@
+package service
+
+import (
+	"context"
+	"github.com/SpawNKZ/content_service/common/auth"
+	"github.com/SpawNKZ/content_service/common/errors"
+	"github.com/SpawNKZ/content_service/common/pagination"
+	contentModel "github.com/SpawNKZ/content_service/content/models"
+	content "github.com/SpawNKZ/content_service/content/service"
+	contentHistoryModel "github.com/SpawNKZ/content_service/content_history/models"
+	contentHistory "github.com/SpawNKZ/content_service/content_history/service"
+	contentStatusModel "github.com/SpawNKZ/content_service/content_status/models"
+	contentStatus "github.com/SpawNKZ/content_service/content_status/service"
+	"github.com/SpawNKZ/content_service/review/models"
+	repository "github.com/SpawNKZ/content_service/review/repo"
+	"time"
+	"unicode/utf8"
+)
+
+const (
+	actionRequestReview  = "request_review"
+	actionComment        = "review_comment"
+	actionApprove        = "approve"
+	actionRequestChanges = "request_changes"
+)
+
+type Service interface {
+	Create(ctx context.Context, data models.CreateRequest) (string, error)
+	GetOne(ctx context.Context, data models.IdRequest) (*models.Review, error)
+	GetList(ctx context.Context, data models.GetListRequest) ([]*models.Review, models.Pagination, error)
+	Comment(ctx context.Context, data models.CommentRequest) error
+	Approve(ctx context.Context, data models.DecisionRequest) error
+	RequestChanges(ctx context.Context, data models.DecisionRequest) error
+}
+
+type service struct {
+	repo    repository.Repository
+	content content.Service
+	status  contentStatus.Service
+	history contentHistory.Service
+}
+
+func New(repo repository.Repository, content content.Service, status contentStatus.Service, history contentHistory.Service) Service {
+	return &service{
+		repo:    repo,
+		content: content,
+		status:  status,
+		history: history,
+	}
+}
+
+func (s *service) Create(ctx context.Context, data models.CreateRequest) (string, error) {
+	identity, ok := auth.FromContext(ctx)
+	if !ok {
+		return "", errors.ErrUnauthorized
+	}
+
+	switch data.ReviewerId {
+	case "":
+		return "", errors.ErrValidation.WithFields(errors.FieldError{Field: "reviewer_id", Message: "is required"})
+	case identity.UserId:
+		return "", errors.ErrValidation.WithFields(errors.FieldError{Field: "reviewer_id", Message: "should not be the requester"})
+	}
+
+	contentObj, err := s.content.GetOne(ctx, contentModel.IdRequest{ID: data.ContentId})
+	if err != nil {
+		return "", err
+	}
+
+	if contentObj.AuthorId != identity.UserId && !identity.IsAdmin() {
+		return "", errors.ErrForbidden
+	}
+
+	_, err = s.repo.FindPending(ctx, data.ContentId)
+	switch {
+	case err == nil:
+		return "", errors.ErrAlreadyExists
+	case !errors.Is(err, errors.ErrNotFound):
+		return "", err
+	}
+
+	reviewObj := data.ToReview()
+	reviewObj.RequesterId = identity.UserId
+
+	reviewId, err := s.repo.Insert(ctx, *reviewObj)
+	if err != nil {
+		return "", err
+	}
+
+	err = s.record(ctx, data.ContentId, identity.UserId, actionRequestReview, "", reviewId)
+	if err != nil {
+		return "", err
+	}
+
+	return reviewId, nil
+}
+
+func (s *service) GetOne(ctx context.Context, data models.IdRequest) (*models.Review, error) {
+	return s.repo.FindByID(ctx, data.ID)
+}
+
+func (s *service) GetList(ctx context.Context, data models.GetListRequest) ([]*models.Review, models.Pagination, error) {
+	limit, offset, err := pagination.Normalize(data.Limit, data.Offset)
+	if err != nil {
+		return nil, models.Pagination{}, err
+	}
+	data.Limit, data.Offset = limit, offset
+
+	count, err := s.repo.Count(ctx, data.ReviewFilter)
+	if err != nil {
+		return nil, models.Pagination{}, errors.ErrDB
+	}
+
+	reviewList, err := s.repo.GetAll(ctx, int64(data.Limit), int64(data.Offset), data.ReviewFilter)
+	if err != nil {
+		return nil, models.Pagination{}, errors.ErrDB
+	}
+
+	hasNextPage := int64(data.Offset+data.Limit) < count
+
+	return reviewList, models.Pagination{
+		Total:      int(count),
+		Limit:      data.Limit,
+		Offset:     data.Offset,
+		IsLastPage: !hasNextPage,
+	}, nil
+}
+
+func (s *service) Comment(ctx context.Context, data models.CommentRequest) error {
+	identity, ok := auth.FromContext(ctx)
+	if !ok {
+		return errors.ErrUnauthorized
+	}
+
+	reviewObj, err := s.repo.FindByID(ctx, data.ID)
+	if err != nil {
+		return err
+	}
+
+	if reviewObj.State != models.StatePending {
+		return errors.ErrInvalidState
+	}
+
+	isParticipant := identity.UserId == reviewObj.ReviewerId || identity.UserId == reviewObj.RequesterId
+	if !isParticipant && !identity.IsAdmin() {
+		return errors.ErrForbidden
+	}
+
+	contentObj, err := s.content.GetOne(ctx, contentModel.IdRequest{ID: reviewObj.ContentId})
+	if err != nil {
+		return err
+	}
+
+	if data.RangeStart < 0 || data.RangeStart > data.RangeEnd || data.RangeEnd > utf8.RuneCountInString(contentObj.Body) {
+		return errors.ErrInvalidRange
+	}
+
+	commentObj := data.ToComment()
+	commentObj.AuthorId = identity.UserId
+
+	err = s.repo.AddComment(ctx, data.ID, *commentObj)
+	if err != nil {
+		return err
+	}
+
+	// The history is readable by every author, the comment itself stays on
+	// the review.
+	return s.record(ctx, reviewObj.ContentId, identity.UserId, actionComment, "", data.ID)
+}
+
+func (s *service) Approve(ctx context.Context, data models.DecisionRequest) error {
+	reviewObj, identity, err := s.pending(ctx, data.ID)
+	if err != nil {
+		return err
+	}
+
+	publishedObj, err := s.status.GetByName(ctx, contentStatusModel.StatusPublished)
+	if err != nil {
+		return err
+	}
+
+	// The content is published first: the content policy refuses reviewers
+	// without the reviewer group, and the review must stay pending then.
+	err = s.content.ChangeStatus(ctx, contentModel.ChangeStatusRequest{
+		ID:       reviewObj.ContentId,
+		StatusId: publishedObj.ID,
+	})
+	if err != nil {
+		return err
+	}
+
+	err = s.decide(ctx, reviewObj, identity, data.Comment, models.StateApproved)
+	if err != nil {
+		return err
+	}
+
+	return s.record(ctx, reviewObj.ContentId, identity.UserId, actionApprove, models.StatePending, models.StateApproved)
+}
+
+func (s *service) RequestChanges(ctx context.Context, data models.DecisionRequest) error {
+	reviewObj, identity, err := s.pending(ctx, data.ID)
+	if err != nil {
+		return err
+	}
+
+	err = s.decide(ctx, reviewObj, identity, data.Comment, models.StateChangesRequested)
+	if err != nil {
+		return err
+	}
+
+	return s.record(ctx, reviewObj.ContentId, identity.UserId, actionRequestChanges, models.StatePending, models.StateChangesRequested)
+}
+
+// pending returns the review the caller may decide on: a pending one,
+// assigned to the caller unless they are an admin.
+func (s *service) pending(ctx context.Context, id string) (*models.Review, *auth.Identity, error) {
+	identity, ok := auth.FromContext(ctx)
+	if !ok {
+		return nil, nil, errors.ErrUnauthorized
+	}
+
+	reviewObj, err := s.repo.FindByID(ctx, id)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if identity.UserId != reviewObj.ReviewerId && !identity.IsAdmin() {
+		return nil, nil, errors.ErrForbidden
+	}
+
+	if reviewObj.State != models.StatePending {
+		return nil, nil, errors.ErrInvalidState
+	}
+
+	return reviewObj, identity, nil
+}
+
+// decide moves the pending review into the given state, storing the optional
+// decision comment on the review. The state is changed first, so that a
+// concurrent decision fails without leaving its comment.
+func (s *service) decide(ctx context.Context, reviewObj *models.Review, identity *auth.Identity, comment string, state string) error {
+	err := s.repo.UpdateState(ctx, reviewObj.ID, state)
+	if err != nil {
+		return err
+	}
+
+	if comment == "" {
+		return nil
+	}
+	return s.repo.AddComment(ctx, reviewObj.ID, models.Comment{AuthorId: identity.UserId, Body: comment})
+}
+
+func (s *service) record(ctx context.Context, contentId string, userId string, action string, previousValue string, newValue string) error {
+	return s.history.Create(ctx, contentHistoryModel.ContentHistory{
+		ContentId:     contentId,
+		UserId:        userId,
+		Action:        action,
+		PreviousValue: previousValue,
+		NewValue:      newValue,
+		CreatedAt:     time.Now(),
+	})
+}
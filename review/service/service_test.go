@@ -0,0 +1,284 @@
+package service
+
+import (
+	"context"
+	"github.com/SpawNKZ/content_service/common/auth"
+	"github.com/SpawNKZ/content_service/common/errors"
+	contentModel "github.com/SpawNKZ/content_service/content/models"
+	content "github.com/SpawNKZ/content_service/content/service"
+	contentHistoryModel "github.com/SpawNKZ/content_service/content_history/models"
+	contentStatusModel "github.com/SpawNKZ/content_service/content_status/models"
+	"github.com/SpawNKZ/content_service/review/models"
+	kitJwt "github.com/go-kit/kit/auth/jwt"
+	"github.com/golang-jwt/jwt/v4"
+	"testing"
+)
+
+const (
+	draftId     = "000000000000000000000001"
+	publishedId = "000000000000000000000002"
+)
+
+func TestApprove(t *testing.T) {
+	tests := []struct {
+		name       string
+		ctx        context.Context
+		want       *errors.AppError
+		wantState  string
+		wantStatus string
+	}{
+		{"reviewer", withIdentity("rita", auth.GroupReviewer), nil, models.StateApproved, publishedId},
+		{"assigned user without the reviewer group", withIdentity("rita", auth.GroupAuthor), errors.ErrForbidden, models.StatePending, draftId},
+		{"other reviewer", withIdentity("rob", auth.GroupReviewer), errors.ErrForbidden, models.StatePending, draftId},
+		{"anonymous", context.Background(), errors.ErrUnauthorized, models.StatePending, draftId},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := newFixture()
+			err := f.service.Approve(tt.ctx, models.DecisionRequest{ID: "review", Comment: "looks good"})
+			assertAppError(t, err, tt.want)
+
+			if state := f.repo.reviews["review"].State; state != tt.wantState {
+				t.Errorf("review state = %s, want %s", state, tt.wantState)
+			}
+			if status := f.content.content["content"].StatusId; status != tt.wantStatus {
+				t.Errorf("content status = %s, want %s", status, tt.wantStatus)
+			}
+			if tt.want != nil && len(f.repo.reviews["review"].Comments) != 0 {
+				t.Errorf("refused decision left its comment")
+			}
+		})
+	}
+}
+
+func TestRequestChanges(t *testing.T) {
+	f := newFixture()
+	err := f.service.RequestChanges(withIdentity("rita", auth.GroupReviewer), models.DecisionRequest{ID: "review"})
+	assertAppError(t, err, nil)
+
+	if state := f.repo.reviews["review"].State; state != models.StateChangesRequested {
+		t.Errorf("review state = %s, want %s", state, models.StateChangesRequested)
+	}
+	if status := f.content.content["content"].StatusId; status != draftId {
+		t.Errorf("content status = %s, want it unchanged", status)
+	}
+
+	err = f.service.Approve(withIdentity("rita", auth.GroupReviewer), models.DecisionRequest{ID: "review"})
+	assertAppError(t, err, errors.ErrInvalidState)
+}
+
+func TestCreate(t *testing.T) {
+	tests := []struct {
+		name       string
+		ctx        context.Context
+		reviewerId string
+		want       *errors.AppError
+	}{
+		{"author", withIdentity("alice", auth.GroupAuthor), "rita", nil},
+		{"no reviewer", withIdentity("alice", auth.GroupAuthor), "", errors.ErrValidation},
+		{"reviewing oneself", withIdentity("alice", auth.GroupAuthor), "alice", errors.ErrValidation},
+		{"other author", withIdentity("bob", auth.GroupAuthor), "rita", errors.ErrForbidden},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := newFixture()
+			delete(f.repo.reviews, "review")
+			_, err := f.service.Create(tt.ctx, models.CreateRequest{ContentId: "content", ReviewerId: tt.reviewerId})
+			assertAppError(t, err, tt.want)
+		})
+	}
+}
+
+func TestCommentKeepsBodyOutOfHistory(t *testing.T) {
+	f := newFixture()
+	err := f.service.Comment(withIdentity("rita", auth.GroupReviewer), models.CommentRequest{ID: "review", Body: "secret", RangeStart: 0, RangeEnd: 4})
+	assertAppError(t, err, nil)
+
+	for _, entry := range f.history.entries {
+		if entry.NewValue == "secret" || entry.PreviousValue == "secret" {
+			t.Fatalf("history entry %+v holds the comment body", entry)
+		}
+	}
+}
+
+type fixture struct {
+	service Service
+	repo    *fakeRepository
+	content *fakeContentService
+	history *fakeHistoryService
+}
+
+func newFixture() fixture {
+	repo := &fakeRepository{reviews: map[string]*models.Review{
+		"review": {ID: "review", ContentId: "content", RequesterId: "alice", ReviewerId: "rita", State: models.StatePending},
+	}}
+	contentSvc := &fakeContentService{content: map[string]*contentModel.Content{
+		"content": {ID: "content", AuthorId: "alice", StatusId: draftId, Body: "body of the content"},
+	}}
+	status := &fakeStatusService{statuses: []*contentStatusModel.ContentStatus{
+		{ID: draftId, Name: contentStatusModel.StatusDraft, IsRemovable: true},
+		{ID: publishedId, Name: contentStatusModel.StatusPublished},
+	}}
+	history := &fakeHistoryService{}
+	return fixture{
+		service: New(repo, content.NewPolicyService(contentSvc, status), status, history),
+		repo:    repo,
+		content: contentSvc,
+		history: history,
+	}
+}
+
+func withIdentity(userId string, groups ...string) context.Context {
+	claimGroups := make([]interface{}, 0, len(groups))
+	for _, group := range groups {
+		claimGroups = append(claimGroups, group)
+	}
+	return context.WithValue(context.Background(), kitJwt.JWTClaimsContextKey, jwt.MapClaims{
+		"sub":    userId,
+		"groups": claimGroups,
+	})
+}
+
+func assertAppError(t *testing.T, err error, want *errors.AppError) {
+	t.Helper()
+	if want == nil {
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		return
+	}
+	if !errors.Is(err, want) {
+		t.Fatalf("err = %v, want %s", err, want.Code)
+	}
+}
+
+type fakeRepository struct {
+	reviews map[string]*models.Review
+}
+
+func (r *fakeRepository) Insert(_ context.Context, reviewObj models.Review) (string, error) {
+	reviewObj.ID = "inserted"
+	reviewObj.State = models.StatePending
+	r.reviews[reviewObj.ID] = &reviewObj
+	return reviewObj.ID, nil
+}
+
+func (r *fakeRepository) FindByID(_ context.Context, id string) (*models.Review, error) {
+	reviewObj, ok := r.reviews[id]
+	if !ok {
+		return nil, errors.ErrNotFound
+	}
+	copied := *reviewObj
+	return &copied, nil
+}
+
+func (r *fakeRepository) FindPending(_ context.Context, contentId string) (*models.Review, error) {
+	for _, reviewObj := range r.reviews {
+		if reviewObj.ContentId == contentId && reviewObj.State == models.StatePending {
+			return reviewObj, nil
+		}
+	}
+	return nil, errors.ErrNotFound
+}
+
+func (r *fakeRepository) AddComment(_ context.Context, id string, commentObj models.Comment) error {
+	reviewObj, ok := r.reviews[id]
+	if !ok {
+		return errors.ErrNotFound
+	}
+	reviewObj.Comments = append(reviewObj.Comments, &commentObj)
+	return nil
+}
+
+func (r *fakeRepository) UpdateState(_ context.Context, id string, state string) error {
+	reviewObj, ok := r.reviews[id]
+	if !ok || reviewObj.State != models.StatePending {
+		return errors.ErrInvalidState
+	}
+	reviewObj.State = state
+	return nil
+}
+
+func (r *fakeRepository) Count(context.Context, models.ReviewFilter) (int64, error) {
+	return int64(len(r.reviews)), nil
+}
+
+func (r *fakeRepository) GetAll(context.Context, int64, int64, models.ReviewFilter) ([]*models.Review, error) {
+	return nil, nil
+}
+
+// fakeContentService stands behind the content policy, as the content
+// service does in the application.
+type fakeContentService struct {
+	content.Service
+	content map[string]*contentModel.Content
+}
+
+func (s *fakeContentService) GetOne(_ context.Context, data contentModel.IdRequest) (*contentModel.Content, error) {
+	contentObj, ok := s.content[data.ID]
+	if !ok {
+		return nil, errors.ErrNotFound
+	}
+	copied := *contentObj
+	return &copied, nil
+}
+
+func (s *fakeContentService) ChangeStatus(_ context.Context, data contentModel.ChangeStatusRequest) error {
+	contentObj, ok := s.content[data.ID]
+	if !ok {
+		return errors.ErrNotFound
+	}
+	contentObj.StatusId = data.StatusId
+	return nil
+}
+
+type fakeStatusService struct {
+	statuses []*contentStatusModel.ContentStatus
+}
+
+func (s *fakeStatusService) Create(context.Context, contentStatusModel.CreateRequest) (string, error) {
+	return "", errors.ErrInternal
+}
+
+func (s *fakeStatusService) GetOne(_ context.Context, data contentStatusModel.IdRequest) (*contentStatusModel.ContentStatus, error) {
+	for _, status := range s.statuses {
+		if status.ID == data.ID {
+			return status, nil
+		}
+	}
+	return nil, errors.ErrNotFound
+}
+
+func (s *fakeStatusService) GetByName(_ context.Context, name string) (*contentStatusModel.ContentStatus, error) {
+	for _, status := range s.statuses {
+		if status.Name == name {
+			return status, nil
+		}
+	}
+	return nil, errors.ErrNotFound
+}
+
+func (s *fakeStatusService) Update(context.Context, contentStatusModel.UpdateRequest) error {
+	return errors.ErrInternal
+}
+
+func (s *fakeStatusService) Delete(context.Context, contentStatusModel.DeleteRequest) error {
+	return errors.ErrInternal
+}
+
+func (s *fakeStatusService) GetList(context.Context, contentStatusModel.GetListRequest) ([]*contentStatusModel.ContentStatus, contentStatusModel.Pagination, error) {
+	return s.statuses, contentStatusModel.Pagination{Total: len(s.statuses)}, nil
+}
+
+type fakeHistoryService struct {
+	entries []contentHistoryModel.ContentHistory
+}
+
+func (s *fakeHistoryService) Create(_ context.Context, data contentHistoryModel.ContentHistory) error {
+	s.entries = append(s.entries, data)
+	return nil
+}
+
+func (s *fakeHistoryService) GetList(context.Context, contentHistoryModel.GetListRequest) ([]*contentHistoryModel.ContentHistory, contentHistoryModel.Pagination, error) {
+	return nil, contentHistoryModel.Pagination{}, nil
+}
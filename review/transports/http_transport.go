@@ -0,0 +1,136 @@
+package transports
+
+import (
+	"context"
+	"github.com/SpawNKZ/content_service/common/transport"
+	"github.com/SpawNKZ/content_service/common/validation"
+	"github.com/SpawNKZ/content_service/review/endpoints"
+	"github.com/SpawNKZ/content_service/review/models"
+	reviewService "github.com/SpawNKZ/content_service/review/service"
+	"github.com/go-kit/kit/endpoint"
+	httpTransport "github.com/go-kit/kit/transport/http"
+	"github.com/go-kit/log"
+	"github.com/gorilla/mux"
+	"net/http"
+)
+
+func MakeHTTPHandler(s reviewService.Service, authMiddleware endpoint.Middleware, logger log.Logger) http.Handler {
+	r := mux.NewRouter()
+	options := transport.ServerOptions(logger)
+	validate := validation.Middleware()
+	// GET     /review                             retrieve review requests, filtered by content, reviewer or state
+	// GET     /review/:id                         retrieve review request by id with its comments
+	// POST    /review                             request a review of the content
+	// POST    /review/:id/comment                 comment a range of the content body
+	// PUT     /review/:id/approve                 approve the content, it becomes published
+	// PUT     /review/:id/request-changes         send the content back to its author
+	sr := r.PathPrefix("/api/v1/review").Subrouter()
+
+	sr.Methods("POST").Path("").Handler(httpTransport.NewServer(
+		authMiddleware(validate(endpoints.MakeCreateEndpoint(s))),
+		decodeCreateRequest,
+		transport.EncodeResponse,
+		options...,
+	))
+	sr.Methods("GET").Path("/{id}").Handler(httpTransport.NewServer(
+		authMiddleware(endpoints.MakeGetOneEndpoint(s)),
+		decodeGetOneRequest,
+		transport.EncodeResponse,
+		options...,
+	))
+	sr.Methods("GET").Path("").Handler(httpTransport.NewServer(
+		authMiddleware(endpoints.MakeGetListEndpoint(s)),
+		decodeGetListRequest,
+		transport.EncodeResponse,
+		options...,
+	))
+	sr.Methods("POST").Path("/{id}/comment").Handler(httpTransport.NewServer(
+		authMiddleware(validate(endpoints.MakeCommentEndpoint(s))),
+		decodeCommentRequest,
+		transport.EncodeResponse,
+		options...,
+	))
+	sr.Methods("PUT").Path("/{id}/approve").Handler(httpTransport.NewServer(
+		authMiddleware(validate(endpoints.MakeApproveEndpoint(s))),
+		decodeDecisionRequest,
+		transport.EncodeResponse,
+		options...,
+	))
+	sr.Methods("PUT").Path("/{id}/request-changes").Handler(httpTransport.NewServer(
+		authMiddleware(validate(endpoints.MakeRequestChangesEndpoint(s))),
+		decodeDecisionRequest,
+		transport.EncodeResponse,
+		options...,
+	))
+	return r
+}
+
+func decodeCreateRequest(_ context.Context, r *http.Request) (request interface{}, err error) {
+	var req models.CreateRequest
+	if err := transport.DecodeJSON(r, &req); err != nil {
+		return nil, err
+	}
+	return req, nil
+}
+
+func decodeGetOneRequest(_ context.Context, r *http.Request) (request interface{}, err error) {
+	id, err := transport.PathVar(r, "id")
+	if err != nil {
+		return nil, err
+	}
+	return models.IdRequest{ID: id}, nil
+}
+
+func decodeCommentRequest(_ context.Context, r *http.Request) (request interface{}, err error) {
+	id, err := transport.PathVar(r, "id")
+	if err != nil {
+		return nil, err
+	}
+	var comment models.CommentRequest
+	if err := transport.DecodeJSON(r, &comment); err != nil {
+		return nil, err
+	}
+
+	comment.ID = id
+
+	return comment, nil
+}
+
+func decodeDecisionRequest(_ context.Context, r *http.Request) (request interface{}, err error) {
+	id, err := transport.PathVar(r, "id")
+	if err != nil {
+		return nil, err
+	}
+	var decision models.DecisionRequest
+	if r.ContentLength != 0 {
+		if err := transport.DecodeJSON(r, &decision); err != nil {
+			return nil, err
+		}
+	}
+
+	decision.ID = id
+
+	return decision, nil
+}
+
+func decodeGetListRequest(_ context.Context, r *http.Request) (request interface{}, err error) {
+	contentId := r.URL.Query().Get("content_id")
+	reviewerId := r.URL.Query().Get("reviewer_id")
+	state := r.URL.Query().Get("state")
+
+	limit, offset, err := transport.Pagination(r)
+	if err != nil {
+		return nil, err
+	}
+
+	return models.GetListRequest{
+		ReqPagination: models.ReqPagination{
+			Limit: limit, Offset: offset,
+		},
+		ReviewFilter: models.ReviewFilter{
+			ContentId:  contentId,
+			ReviewerId: reviewerId,
+			State:      state,
+		},
+	}, nil
+}
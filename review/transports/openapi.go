@@ -0,0 +1,26 @@
+package transports
+
+import (
+	"github.com/SpawNKZ/content_service/common/openapi"
+	"github.com/SpawNKZ/content_service/review/models"
+)
+
+// OpenAPIOperations describes the routes registered by MakeHTTPHandler.
+func OpenAPIOperations() []openapi.Operation {
+	const tag = "review"
+	id := openapi.PathParam("id")
+	return []openapi.Operation{
+		{Method: "POST", Path: "/api/v1/review", Tag: tag, Summary: "Request a review of content", Request: models.CreateRequest{}, Response: models.CreateResponse{}, Secured: true},
+		{Method: "GET", Path: "/api/v1/review/{id}", Tag: tag, Summary: "Get a review by ID", Params: []openapi.Param{id}, Response: models.GetOneResponse{}, Secured: true},
+		{Method: "GET", Path: "/api/v1/review", Tag: tag, Summary: "List reviews",
+			Params: append([]openapi.Param{
+				openapi.QueryParam("content_id", "string", ""),
+				openapi.QueryParam("reviewer_id", "string", ""),
+				openapi.QueryParam("state", "string", "One of pending, approved and changes_requested."),
+			}, openapi.PaginationParams()...),
+			Response: models.GetListResponse{}, Secured: true},
+		{Method: "POST", Path: "/api/v1/review/{id}/comment", Tag: tag, Summary: "Comment a range of the content body", Params: []openapi.Param{id}, Request: models.CommentRequest{}, Response: models.UpdateResponse{}, Secured: true},
+		{Method: "PUT", Path: "/api/v1/review/{id}/approve", Tag: tag, Summary: "Approve the content", Params: []openapi.Param{id}, Request: models.DecisionRequest{}, Response: models.UpdateResponse{}, Secured: true},
+		{Method: "PUT", Path: "/api/v1/review/{id}/request-changes", Tag: tag, Summary: "Request changes to the content", Params: []openapi.Param{id}, Request: models.DecisionRequest{}, Response: models.UpdateResponse{}, Secured: true},
+	}
+}
@@ -0,0 +1,283 @@
+package main
+
+import (
+	"context"
+	stdErrors "errors"
+	"flag"
+	"fmt"
+	"github.com/SpawNKZ/content_service/common/auth"
+	"github.com/SpawNKZ/content_service/common/compress"
+	"github.com/SpawNKZ/content_service/common/health"
+	"github.com/SpawNKZ/content_service/common/instrumenting"
+	"github.com/SpawNKZ/content_service/common/lifecycle"
+	"github.com/SpawNKZ/content_service/common/openapi"
+	"github.com/SpawNKZ/content_service/common/requestid"
+	"github.com/SpawNKZ/content_service/common/tracing"
+	contentRepo "github.com/SpawNKZ/content_service/content/repo"
+	contentService "github.com/SpawNKZ/content_service/content/service"
+	content "github.com/SpawNKZ/content_service/content/transports"
+	contentHistoryRepo "github.com/SpawNKZ/content_service/content_history/repo"
+	contentHistoryService "github.com/SpawNKZ/content_service/content_history/service"
+	contentHistory "github.com/SpawNKZ/content_service/content_history/transports"
+	contentStatusRepo "github.com/SpawNKZ/content_service/content_status/repo"
+	contentStatusService "github.com/SpawNKZ/content_service/content_status/service"
+	contentStatus "github.com/SpawNKZ/content_service/content_status/transports"
+	"github.com/SpawNKZ/content_service/db"
+	"github.com/SpawNKZ/content_service/mb"
+	"github.com/SpawNKZ/content_service/pb"
+	postRepo "github.com/SpawNKZ/content_service/post/repo"
+	postService "github.com/SpawNKZ/content_service/post/service"
+	post "github.com/SpawNKZ/content_service/post/transports"
+	postCategoryRepo "github.com/SpawNKZ/content_service/post_category/repo"
+	postCategoryService "github.com/SpawNKZ/content_service/post_category/service"
+	postCategory "github.com/SpawNKZ/content_service/post_category/transports"
+	reviewRepo "github.com/SpawNKZ/content_service/review/repo"
+	reviewService "github.com/SpawNKZ/content_service/review/service"
+	review "github.com/SpawNKZ/content_service/review/transports"
+	kitGrpc "github.com/go-kit/kit/transport/grpc"
+	"github.com/go-kit/log"
+	"github.com/gorilla/mux"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"go.opentelemetry.io/contrib/instrumentation/go.mongodb.org/mongo-driver/mongo/otelmongo"
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/reflection"
+
+	"github.com/SpawNKZ/content_service/utils"
+	"net"
+	"net/http"
+)
+
+// runServe serves the HTTP and gRPC APIs until a termination signal is
+// received or a listener fails.
+func runServe(config utils.Config, logger log.Logger, args []string) error {
+	fs := flag.NewFlagSet("serve", flag.ExitOnError)
+	var (
+		httpAddr = fs.String("http.addr", ":"+config.HTTP_PORT, "HTTP listen address")
+		grpcAddr = fs.String("grpc.addr", ":"+config.GRPCPort, "gRPC listen address")
+	)
+	fs.Parse(args)
+
+	// Tokens signed with a guessable key would grant any group.
+	if config.JWTSecret == "" {
+		return stdErrors.New("JWT_SECRET is not set")
+	}
+	if err := config.Validate(); err != nil {
+		return err
+	}
+
+	lc := lifecycle.New(log.With(logger, "component", "lifecycle"), config.ShutdownDrainDelay, config.ShutdownTimeout)
+
+	shutdownTracing, err := tracing.NewProvider(context.Background(), config.TraceExporter, config.TraceEndpoint, "content_service")
+	if err != nil {
+		logger.Log("cannot initialize tracing", err)
+	}
+
+	mongoLatency := instrumenting.NewLatencyHistogram("mongo", "command_latency_seconds", "Duration of Mongo commands in seconds.", "command", "error")
+	dbConn, err := db.NewDBConnection(context.Background(), config.DBSource, logger,
+		options.Client().SetMonitor(db.ComposeMonitors(db.NewCommandMonitor(mongoLatency), otelmongo.NewMonitor())))
+	if err != nil {
+		return err
+	}
+	// Creating, reordering and deleting posts run in transactions.
+	if err := db.RequireTransactions(context.Background(), dbConn); err != nil {
+		return err
+	}
+
+	nc, drainNats, err := mb.NewNatsConnection(config.NATS, mb.ReconnectPolicy{
+		MaxReconnects: config.NATSMaxReconnects,
+		ReconnectWait: config.NATSReconnectWait,
+	}, log.With(logger, "component", "nats"))
+	if err != nil {
+		logger.Log("cannot connect to NATS", err)
+	}
+
+	var contentHistorySvc contentHistoryService.Service
+	contentHistoryRepository := contentHistoryRepo.NewRepository(dbConn)
+	contentHistorySvc = contentHistoryService.New(contentHistoryRepository)
+	contentHistorySvc = contentHistoryService.NewInstrumentingService(instrumenting.NewRequestMetrics("content_history"), contentHistorySvc)
+	contentHistorySvc = contentHistoryService.NewLoggingService(log.With(logger, "component", "contentHistory"), contentHistorySvc)
+
+	contentRepository := contentRepo.NewRepository(dbConn)
+
+	var contentStatusSvc contentStatusService.Service
+	contentStatusRepository := contentStatusRepo.NewRepository(dbConn)
+	// The unique index on the status name goes first, so that replicas
+	// starting together cannot seed the same status twice.
+	if err := db.EnsureIndexes(context.Background(), dbConn); err != nil {
+		logger.Log("cannot create indexes", err)
+	}
+	if err := contentStatusService.Seed(context.Background(), contentStatusRepository); err != nil {
+		logger.Log("cannot seed content statuses", err)
+	}
+	// Migrations go after the seed, the status ones refer to seeded statuses.
+	if err := db.Migrate(context.Background(), dbConn, log.With(logger, "component", "migrations")); err != nil {
+		return err
+	}
+	contentStatusSvc = contentStatusService.New(contentStatusRepository, contentService.NewStatusUsage(contentRepository, contentHistorySvc))
+	contentStatusSvc = contentStatusService.NewInstrumentingService(instrumenting.NewRequestMetrics("content_status"), contentStatusSvc)
+	contentStatusSvc = contentStatusService.NewLoggingService(log.With(logger, "component", "contentStatus"), contentStatusSvc)
+
+	var contentSvc contentService.Service
+	natsLatency := instrumenting.NewLatencyHistogram("nats", "request_latency_seconds", "Duration of NATS requests in seconds.", "subject", "error")
+	subjectPolicy := mb.RequestPolicy{
+		Timeout:         config.NATSSubjectTimeout,
+		Retries:         config.NATSRetries,
+		Backoff:         config.NATSRetryBackoff,
+		BreakerFailures: config.NATSBreakerFailures,
+		BreakerTimeout:  config.NATSBreakerTimeout,
+	}
+	microtopicPolicy := subjectPolicy
+	microtopicPolicy.Timeout = config.NATSMicrotopicTimeout
+	natsLogger := log.With(logger, "component", "nats")
+	subjectRepository := contentRepo.NewInstrumentingSubjectRepository(natsLatency, contentRepo.NewSubjectRepository(nc, subjectPolicy, natsLogger))
+	microtopicRepository := contentRepo.NewInstrumentingMicrotopicRepository(natsLatency, contentRepo.NewMicrotopicRepository(nc, microtopicPolicy, natsLogger))
+	subjectCache := contentRepo.NewSubjectCache(config.SubjectCacheTTL)
+	contentSvc = contentService.New(contentRepository, subjectRepository, microtopicRepository, contentHistorySvc, contentStatusSvc, subjectCache, config.SubjectDegradation)
+	contentSvc = contentService.NewPolicyService(contentSvc, contentStatusSvc)
+	contentSvc = contentService.NewInstrumentingService(instrumenting.NewRequestMetrics("content"), contentSvc)
+	contentSvc = contentService.NewLoggingService(log.With(logger, "component", "content"), contentSvc)
+
+	postRepository := postRepo.NewRepository(dbConn)
+
+	var postCategorySvc postCategoryService.Service
+	postCategoryRepository := postCategoryRepo.NewRepository(dbConn)
+	postCategorySvc = postCategoryService.New(postCategoryRepository, postService.NewCategoryUsage(postRepository))
+	postCategorySvc = postCategoryService.NewInstrumentingService(instrumenting.NewRequestMetrics("post_category"), postCategorySvc)
+	postCategorySvc = postCategoryService.NewLoggingService(log.With(logger, "component", "postCategory"), postCategorySvc)
+
+	var postSvc postService.Service
+	postSvc = postService.New(postRepository, contentSvc, postCategorySvc, contentHistorySvc)
+	postSvc = postService.NewPolicyService(postSvc, contentSvc)
+	postSvc = postService.NewInstrumentingService(instrumenting.NewRequestMetrics("post"), postSvc)
+	postSvc = postService.NewLoggingService(log.With(logger, "component", "post"), postSvc)
+
+	var reviewSvc reviewService.Service
+	reviewRepository := reviewRepo.NewRepository(dbConn)
+	reviewSvc = reviewService.New(reviewRepository, contentSvc, contentStatusSvc, contentHistorySvc)
+	reviewSvc = reviewService.NewInstrumentingService(instrumenting.NewRequestMetrics("review"), reviewSvc)
+	reviewSvc = reviewService.NewLoggingService(log.With(logger, "component", "review"), reviewSvc)
+
+	httpLogger := log.With(logger, "component", "http")
+	grpcLogger := log.With(logger, "component", "grpc")
+
+	r := mux.NewRouter()
+	r.Use(requestid.Middleware, compress.Middleware, accessControl)
+	r.Handle("/metrics", promhttp.Handler())
+	r.Handle("/healthz", health.LivenessHandler())
+	r.Handle("/readyz", health.ReadinessHandler(config.HealthCheckTimeout,
+		health.Component{Name: "mongo", Required: config.IsRequired("mongo"), Check: health.MongoCheck(dbConn)},
+		health.Component{Name: "nats", Required: config.IsRequired("nats"), Check: health.NATSCheck(nc)},
+		health.Component{Name: "lifecycle", Required: true, Check: lc.Check},
+	))
+
+	authMiddleware := auth.NewParser(config.JWTSecret)
+
+	contentStatusHandler := contentStatus.MakeHTTPHandler(contentStatusSvc, authMiddleware, httpLogger)
+	contentHistoryHandler := contentHistory.MakeHTTPHandler(contentHistorySvc, authMiddleware, httpLogger)
+	contentHandler := content.MakeHTTPHandler(contentSvc, authMiddleware, httpLogger)
+	postHandler := post.MakeHTTPHandler(postSvc, authMiddleware, httpLogger)
+	postCategoryHandler := postCategory.MakeHTTPHandler(postCategorySvc, authMiddleware, httpLogger)
+	reviewHandler := review.MakeHTTPHandler(reviewSvc, authMiddleware, httpLogger)
+
+	apiDocs := []struct {
+		name       string
+		handler    http.Handler
+		operations []openapi.Operation
+	}{
+		{"content_status", contentStatusHandler, contentStatus.OpenAPIOperations()},
+		{"content_history", contentHistoryHandler, contentHistory.OpenAPIOperations()},
+		{"content", contentHandler, content.OpenAPIOperations()},
+		{"post_category", postCategoryHandler, postCategory.OpenAPIOperations()},
+		{"post", postHandler, post.OpenAPIOperations()},
+		{"review", reviewHandler, review.OpenAPIOperations()},
+	}
+	apiDoc := openapi.NewDocument("Content service", "1.0.0")
+	// A document out of line with the routes would mislead the clients
+	// generated from it.
+	for _, d := range apiDocs {
+		if err := openapi.Verify(d.handler, d.operations); err != nil {
+			return fmt.Errorf("OpenAPI document of %s does not match the routes: %w", d.name, err)
+		}
+		apiDoc.Operations = append(apiDoc.Operations, d.operations...)
+	}
+	r.Handle("/api/v1/openapi.json", openapi.Handler(apiDoc))
+	r.Handle("/api/v1/docs", openapi.UIHandler("/api/v1/openapi.json"))
+
+	r.PathPrefix("/api/v1/content_status").Handler(contentStatusHandler)
+	r.PathPrefix("/api/v1/content_history").Handler(contentHistoryHandler)
+	r.PathPrefix("/api/v1/content").Handler(contentHandler)
+	r.PathPrefix("/api/v1/post_category").Handler(postCategoryHandler)
+	r.PathPrefix("/api/v1/post").Handler(postHandler)
+	r.PathPrefix("/api/v1/review").Handler(reviewHandler)
+
+	server := &http.Server{Addr: *httpAddr, Handler: r}
+
+	grpcServer := grpc.NewServer(grpc.ChainUnaryInterceptor(otelgrpc.UnaryServerInterceptor(), kitGrpc.Interceptor))
+	pb.RegisterContentStatusServiceServer(grpcServer, contentStatus.NewGRPCServer(contentStatusSvc, authMiddleware, grpcLogger))
+	pb.RegisterContentServiceServer(grpcServer, content.NewGRPCServer(contentSvc, authMiddleware, grpcLogger))
+	pb.RegisterPostServiceServer(grpcServer, post.NewGRPCServer(postSvc, authMiddleware, grpcLogger))
+	reflection.Register(grpcServer)
+
+	// Stop accepting requests and finish the in-flight ones before releasing
+	// the dependencies they use.
+	lc.OnShutdown("http", server.Shutdown)
+	lc.OnShutdown("grpc", func(ctx context.Context) error {
+		stopped := make(chan struct{})
+		go func() {
+			grpcServer.GracefulStop()
+			close(stopped)
+		}()
+		select {
+		case <-stopped:
+			return nil
+		case <-ctx.Done():
+			grpcServer.Stop()
+			return ctx.Err()
+		}
+	})
+	lc.OnShutdown("nats", drainNats)
+	if shutdownTracing != nil {
+		lc.OnShutdown("tracing", shutdownTracing)
+	}
+	if dbConn != nil {
+		lc.OnShutdown("mongo", dbConn.Disconnect)
+	}
+
+	errs := make(chan error, 2)
+	go func() {
+		logger.Log("transport", "http", "address", *httpAddr, "msg", "listening")
+		if err := server.ListenAndServe(); err != http.ErrServerClosed {
+			errs <- err
+		}
+	}()
+	go func() {
+		listener, err := net.Listen("tcp", *grpcAddr)
+		if err != nil {
+			errs <- err
+			return
+		}
+		logger.Log("transport", "grpc", "address", *grpcAddr, "msg", "listening")
+		if err := grpcServer.Serve(listener); err != nil {
+			errs <- err
+		}
+	}()
+
+	return lc.Wait(errs)
+}
+
+func accessControl(h http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Access-Control-Allow-Origin", "*")
+		w.Header().Set("Access-Control-Allow-Methods", "*")
+		w.Header().Set("Access-Control-Allow-Headers", "*")
+
+		if r.Method == "OPTIONS" {
+			w.WriteHeader(http.StatusOK)
+			return
+		}
+
+		h.ServeHTTP(w, r)
+	})
+}
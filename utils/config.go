@@ -1,18 +1,53 @@
 package utils
 
 import (
+	"fmt"
 	"github.com/spf13/viper"
+	"time"
 )
 
 // Config stores all configuration of the application.
 // The values are read by viper from a config file or environment variable.
 type Config struct {
-	Environment  string `mapstructure:"ENVIRONMENT"`
-	DBDriver     string `mapstructure:"DB_DRIVER"`
+	Environment string `mapstructure:"ENVIRONMENT"`
+	DBDriver    string `mapstructure:"DB_DRIVER"`
+	// DBSource is the URI of a replica set or a sharded cluster, serve
+	// refuses standalone servers since posts are written in transactions.
 	DBSource     string `mapstructure:"DB_SOURCE"`
 	MigrationURL string `mapstructure:"MIGRATION_URL"`
 	HTTP_PORT    string `mapstructure:"HTTP_PORT"`
+	GRPCPort     string `mapstructure:"GRPC_PORT"`
 	NATS         string `mapstructure:"NATS"`
+	// JWTSecret is the HS256 key of the access tokens, serve refuses to
+	// start without one.
+	JWTSecret     string `mapstructure:"JWT_SECRET"`
+	TraceExporter string `mapstructure:"TRACE_EXPORTER"`
+	TraceEndpoint string `mapstructure:"TRACE_ENDPOINT"`
+	// ReadinessRequired lists the dependencies, among "mongo" and "nats",
+	// without which the service is reported as not ready.
+	ReadinessRequired  []string      `mapstructure:"READINESS_REQUIRED"`
+	HealthCheckTimeout time.Duration `mapstructure:"HEALTH_CHECK_TIMEOUT"`
+	// ShutdownTimeout bounds the time given to in-flight requests and
+	// dependencies to stop once a termination signal is received.
+	ShutdownTimeout time.Duration `mapstructure:"SHUTDOWN_TIMEOUT"`
+	// ShutdownDrainDelay is how long the service keeps serving while reported
+	// as not ready, so that load balancers stop routing to it before it stops.
+	ShutdownDrainDelay time.Duration `mapstructure:"SHUTDOWN_DRAIN_DELAY"`
+	// NATS reconnect policy, a negative NATSMaxReconnects retries forever.
+	NATSMaxReconnects int           `mapstructure:"NATS_MAX_RECONNECTS"`
+	NATSReconnectWait time.Duration `mapstructure:"NATS_RECONNECT_WAIT"`
+	// Request timeouts of the subject service lookups, per NATS subject.
+	NATSSubjectTimeout    time.Duration `mapstructure:"NATS_SUBJECT_TIMEOUT"`
+	NATSMicrotopicTimeout time.Duration `mapstructure:"NATS_MICROTOPIC_TIMEOUT"`
+	// Retries and circuit breaker shared by the subject service lookups.
+	NATSRetries         int           `mapstructure:"NATS_RETRIES"`
+	NATSRetryBackoff    time.Duration `mapstructure:"NATS_RETRY_BACKOFF"`
+	NATSBreakerFailures uint32        `mapstructure:"NATS_BREAKER_FAILURES"`
+	NATSBreakerTimeout  time.Duration `mapstructure:"NATS_BREAKER_TIMEOUT"`
+	// SubjectDegradation is how content is read while the subject service
+	// is unavailable: strict, omit or cache. Serve refuses any other value.
+	SubjectDegradation string        `mapstructure:"SUBJECT_DEGRADATION"`
+	SubjectCacheTTL    time.Duration `mapstructure:"SUBJECT_CACHE_TTL"`
 }
 
 // LoadConfig reads configuration from file or environment variables.
@@ -31,3 +66,24 @@ func LoadConfig(path string) (config Config, err error) {
 	err = viper.Unmarshal(&config)
 	return
 }
+
+// Validate rejects the settings a typo would otherwise turn into another
+// behavior, serve refuses to start with them.
+func (config Config) Validate() error {
+	switch config.SubjectDegradation {
+	case "strict", "omit", "cache":
+		return nil
+	default:
+		return fmt.Errorf("SUBJECT_DEGRADATION is %q, want strict, omit or cache", config.SubjectDegradation)
+	}
+}
+
+// IsRequired reports whether readiness depends on the given component.
+func (config Config) IsRequired(component string) bool {
+	for _, required := range config.ReadinessRequired {
+		if required == component {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,25 @@
+package utils
+
+import "testing"
+
+func TestValidateSubjectDegradation(t *testing.T) {
+	tests := []struct {
+		degradation string
+		wantErr     bool
+	}{
+		{"strict", false},
+		{"omit", false},
+		{"cache", false},
+		{"", true},
+		{"Cache", true},
+		{"fallback", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.degradation, func(t *testing.T) {
+			err := Config{SubjectDegradation: tt.degradation}.Validate()
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Validate() = %v, want error %v", err, tt.wantErr)
+			}
+		})
+	}
+}